@@ -0,0 +1,138 @@
+package ptr
+
+import "reflect"
+
+// Clone returns a pointer to a copy of the value p points to, or nil if p is nil.
+// The copy is shallow, use DeepClone for values holding pointers, slices or maps.
+func Clone[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// CloneSlice clones every pointer of p with Clone, nil pointers stay nil.
+func CloneSlice[T any](p []*T) []*T {
+	c := make([]*T, len(p))
+	for i := range p {
+		c[i] = Clone(p[i])
+	}
+	return c
+}
+
+// CloneMap clones every pointer of p with Clone, nil pointers stay nil.
+func CloneMap[K comparable, T any](p map[K]*T) map[K]*T {
+	c := make(map[K]*T, len(p))
+	for k, v := range p {
+		c[k] = Clone(v)
+	}
+	return c
+}
+
+// DeepClone returns a copy of v that shares no memory with it, recursively
+// copying pointers, slices, maps, arrays, interfaces and exported struct fields.
+// Cycles and pointers shared within v are preserved in the copy.
+//
+// Unexported struct fields can't be set through reflection, so they are copied
+// shallowly, like funcs and channels.
+func DeepClone[T any](v T) T {
+	var dst T
+	c := cloner{visited: map[cloneKey]reflect.Value{}}
+	c.clone(reflect.ValueOf(&dst).Elem(), reflect.ValueOf(&v).Elem())
+	return dst
+}
+
+// cloneValue returns a deep copy of v, as DeepClone does.
+func cloneValue(v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	dst := reflect.New(v.Type()).Elem()
+	c := cloner{visited: map[cloneKey]reflect.Value{}}
+	c.clone(dst, v)
+	return dst
+}
+
+// cloneKey identifies a pointer, map or slice already cloned.
+type cloneKey struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+type cloner struct {
+	visited map[cloneKey]reflect.Value
+}
+
+// clone sets dst, which must be settable and of the type of src, to a deep copy of src.
+func (c *cloner) clone(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		key := cloneKey{typ: src.Type(), ptr: src.Pointer()}
+		if p, ok := c.visited[key]; ok {
+			dst.Set(p)
+			return
+		}
+		p := reflect.New(src.Type().Elem())
+		c.visited[key] = p
+		c.clone(p.Elem(), src.Elem())
+		dst.Set(p)
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		e := reflect.New(src.Elem().Type()).Elem()
+		c.clone(e, src.Elem())
+		dst.Set(e)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		key := cloneKey{typ: src.Type(), ptr: src.Pointer(), len: src.Len()}
+		if s, ok := c.visited[key]; ok {
+			dst.Set(s)
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		c.visited[key] = s
+		for i := 0; i < src.Len(); i++ {
+			c.clone(s.Index(i), src.Index(i))
+		}
+		dst.Set(s)
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		key := cloneKey{typ: src.Type(), ptr: src.Pointer()}
+		if m, ok := c.visited[key]; ok {
+			dst.Set(m)
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		c.visited[key] = m
+		iter := src.MapRange()
+		for iter.Next() {
+			v := reflect.New(src.Type().Elem()).Elem()
+			c.clone(v, iter.Value())
+			m.SetMapIndex(iter.Key(), v)
+		}
+		dst.Set(m)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			c.clone(dst.Index(i), src.Index(i))
+		}
+	case reflect.Struct:
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).IsExported() {
+				c.clone(dst.Field(i), src.Field(i))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+}
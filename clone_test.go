@@ -0,0 +1,100 @@
+package ptr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cloneNode struct {
+	Name     *string
+	Tags     []string
+	Labels   map[string]*int
+	Next     *cloneNode
+	Any      any
+	Pair     [2]*int
+	internal *string
+}
+
+func Test_Clone(t *testing.T) {
+	t.Run("pointer", func(t *testing.T) {
+		p := To(42)
+
+		c := Clone(p)
+		require.NotNil(t, c)
+		assert.NotSame(t, p, c)
+		assert.Equal(t, *p, *c)
+		assert.Nil(t, Clone[int](nil))
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		p := []*int{To(42), nil}
+
+		c := CloneSlice(p)
+		require.Len(t, c, 2)
+		assert.NotSame(t, p[0], c[0])
+		assert.Equal(t, 42, *c[0])
+		assert.Nil(t, c[1])
+	})
+
+	t.Run("map", func(t *testing.T) {
+		p := map[string]*int{"foo": To(42), "bar": nil}
+
+		c := CloneMap(p)
+		require.Len(t, c, 2)
+		assert.NotSame(t, p["foo"], c["foo"])
+		assert.Equal(t, 42, *c["foo"])
+		assert.Nil(t, c["bar"])
+	})
+}
+
+func Test_DeepClone(t *testing.T) {
+	t.Run("no aliasing", func(t *testing.T) {
+		n := &cloneNode{
+			Name:   To("foo"),
+			Tags:   []string{"a"},
+			Labels: map[string]*int{"x": To(1)},
+			Any:    &[]int{1},
+			Pair:   [2]*int{To(2), nil},
+		}
+
+		c := DeepClone(n)
+		assert.Equal(t, n, c)
+
+		*c.Name = "bar"
+		c.Tags[0] = "b"
+		*c.Labels["x"] = 2
+		(*c.Any.(*[]int))[0] = 2
+		*c.Pair[0] = 3
+		assert.Equal(t, "foo", *n.Name)
+		assert.Equal(t, []string{"a"}, n.Tags)
+		assert.Equal(t, 1, *n.Labels["x"])
+		assert.Equal(t, []int{1}, *n.Any.(*[]int))
+		assert.Equal(t, 2, *n.Pair[0])
+	})
+
+	t.Run("cycles and shared pointers", func(t *testing.T) {
+		name := To("foo")
+		n := &cloneNode{Name: name}
+		n.Next = &cloneNode{Name: name, Next: n}
+
+		c := DeepClone(n)
+		assert.Same(t, c, c.Next.Next)
+		assert.Same(t, c.Name, c.Next.Name)
+		assert.NotSame(t, n.Name, c.Name)
+	})
+
+	t.Run("unexported fields are shallow", func(t *testing.T) {
+		n := cloneNode{internal: To("foo")}
+
+		c := DeepClone(n)
+		assert.Same(t, n.internal, c.internal)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, DeepClone[*cloneNode](nil))
+		assert.Nil(t, DeepClone[any](nil))
+		assert.Nil(t, DeepClone([]int(nil)))
+	})
+}
@@ -0,0 +1,347 @@
+// Command ptrgen generates the String/StringValue/StringSlice... family of
+// type wrappers around the generic funcs of github.com/sougiovn/ptr.
+//
+// Without -type it regenerates the builtin wrappers of the ptr package itself,
+// along with the bridges to the sql.NullX types.
+// With -type it generates wrappers for other types into their own file:
+//
+//	//go:generate ptrgen -type=UUID -import=github.com/google/uuid
+//
+// With -from and -to it generates converters between a pair of structs.
+//
+// With -check it writes nothing and fails when the output files are stale.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+const ptrImportPath = "github.com/sougiovn/ptr"
+
+var errStale = errors.New("generated file is stale, run go generate")
+
+type supportedTypes struct {
+	name       string
+	dataType   string
+	importPath string
+	// samples are three literals of dataType used by the builtin tests,
+	// the first two must differ. Types without samples are not tested.
+	samples []string
+	// nonZero suffixes the To wrappers returning nil for the zero value, they are
+	// not generated when empty, as for bool where false is a meaningful value.
+	// They are prefixed with To as NonZero already names the generic func taking
+	// a pointer. isZero is the func telling zero values apart, for types that are
+	// not comparable or have an IsZero method.
+	nonZero string
+	isZero  string
+}
+
+func (t supportedTypes) funcName() string {
+	return exported(t.name)
+}
+
+func (t supportedTypes) String() string {
+	return t.dataType
+}
+
+// numeric returns a builtin type tested with conversions of untyped constants.
+func numeric(name, dataType, importPath string) supportedTypes {
+	return supportedTypes{name, dataType, importPath, []string{dataType + "(42)", dataType + "(69)", dataType + "(99)"}, "NonZero", ""}
+}
+
+// builtinTypes are the wrappers of the ptr package, keep the README list in sync.
+var builtinTypes = []supportedTypes{
+	{"string", "string", "", []string{`"foo"`, `"bar"`, `"baz"`}, "NonEmpty", ""},
+	numeric("byte", "byte", ""),
+	numeric("rune", "rune", ""),
+	{"bool", "bool", "", []string{"true", "false", "true"}, "", ""},
+	numeric("int", "int", ""),
+	numeric("int8", "int8", ""),
+	numeric("int16", "int16", ""),
+	numeric("int32", "int32", ""),
+	numeric("int64", "int64", ""),
+	numeric("uint", "uint", ""),
+	numeric("uint8", "uint8", ""),
+	numeric("uint16", "uint16", ""),
+	numeric("uint32", "uint32", ""),
+	numeric("uint64", "uint64", ""),
+	numeric("uintptr", "uintptr", ""),
+	numeric("float32", "float32", ""),
+	numeric("float64", "float64", ""),
+	numeric("complex64", "complex64", ""),
+	numeric("complex128", "complex128", ""),
+	{"time", "time.Time", "time", []string{"time.Unix(42, 0)", "time.Unix(69, 0)", "time.Unix(99, 0)"}, "NonZero", "time.Time.IsZero"},
+	numeric("duration", "time.Duration", "time"),
+	numeric("month", "time.Month", "time"),
+	numeric("weekday", "time.Weekday", "time"),
+	{"rawMessage", "json.RawMessage", "encoding/json", []string{`json.RawMessage("42")`, `json.RawMessage("69")`, `json.RawMessage("99")`}, "NonEmpty", "func(v json.RawMessage) bool { return len(v) == 0 }"},
+	{"netipAddr", "netip.Addr", "net/netip", []string{`netip.MustParseAddr("127.0.0.1")`, `netip.MustParseAddr("10.0.0.1")`, `netip.MustParseAddr("::1")`}, "NonZero", ""},
+	{"netipAddrPort", "netip.AddrPort", "net/netip", []string{`netip.MustParseAddrPort("127.0.0.1:42")`, `netip.MustParseAddrPort("10.0.0.1:69")`, `netip.MustParseAddrPort("[::1]:99")`}, "NonZero", ""},
+	{"netipPrefix", "netip.Prefix", "net/netip", []string{`netip.MustParsePrefix("127.0.0.0/8")`, `netip.MustParsePrefix("10.0.0.0/8")`, `netip.MustParsePrefix("::1/128")`}, "NonZero", ""},
+}
+
+type wrappersFile struct {
+	Package string
+	Imports []string
+	// Qualifier prefixes the generic funcs and types of the ptr package,
+	// it is empty when generating into the ptr package itself.
+	Qualifier string
+	// Parse adds the Parse wrappers, only the builtin types are known to be parsable.
+	Parse bool
+	Types []wrapperType
+}
+
+type wrapperType struct {
+	Name    string
+	Type    string
+	Samples []string
+	NonZero string
+	IsZero  string
+}
+
+func main() {
+	typeList := flag.String("type", "", "comma separated list of types to generate wrappers for, as Type or Type:Name to name the wrappers after Name, types of the output package default to TypePtr")
+	importPath := flag.String("import", "", "import path of the package declaring -type, empty for types of the output package")
+	pkg := flag.String("package", "", "package name of the output file, defaults to $GOPACKAGE or the output directory name")
+	output := flag.String("output", "", "output file, defaults to ptr_gen.go for the builtin wrappers and to <type>_ptr.go for -type")
+	tests := flag.Bool("tests", false, "also generate tests into the _test.go file next to the output")
+	check := flag.Bool("check", false, "write nothing and fail when the output files are stale")
+	from := flag.String("from", "", "struct to convert from, as import/path.Type; enables the struct converter mode")
+	to := flag.String("to", "", "struct to convert to, as import/path.Type")
+	flag.Parse()
+
+	if *pkg == "" {
+		*pkg = os.Getenv("GOPACKAGE")
+	}
+
+	verb := "generated"
+	if *check {
+		verb = "checked"
+	}
+
+	if *from != "" {
+		if *to == "" || *output == "" {
+			log.Fatalf("-from requires -to and -output\n")
+		}
+		err := genStructs(*from, *to, *output, *pkg, *check)
+		if err != nil {
+			log.Fatalf("unable to generate struct converters: %v\n", err)
+		}
+		log.Printf("%s struct converters between %s and %s in %s\n", verb, *from, *to, *output)
+		return
+	}
+
+	types := builtinTypes
+	if *typeList == "" && *pkg == "" {
+		*pkg = "ptr"
+	}
+	if *typeList != "" {
+		var err error
+		types, err = parseTypes(*typeList, *importPath)
+		if err != nil {
+			log.Fatalf("invalid -type: %v\n", err)
+		}
+	}
+	if *output == "" {
+		*output = defaultOutput(types, *typeList == "")
+	}
+	if *pkg == "" {
+		*pkg = outputPackage(*output)
+	}
+
+	err := genWrappers(types, *pkg, *output, *typeList == "", *check)
+	if err != nil {
+		log.Fatalf("unable to generate type wrappers: %v\n", err)
+	}
+	log.Printf("%s code for types: %v\n", verb, types)
+
+	if *tests {
+		err = genTests(types, *pkg, testFileName(*output), *typeList == "", *check)
+		if err != nil {
+			log.Fatalf("unable to generate test for type wrappers: %v\n", err)
+		}
+		log.Printf("%s code for test types: %v\n", verb, types)
+	}
+
+	if *typeList == "" {
+		sqlOutput := filepath.Join(filepath.Dir(*output), "sql_gen.go")
+		err = genSQLNulls(sqlOutput, *tests, *check)
+		if err != nil {
+			log.Fatalf("unable to generate sql.NullX bridges: %v\n", err)
+		}
+		log.Printf("%s sql.NullX bridges in %s\n", verb, sqlOutput)
+	}
+}
+
+// exported upper cases the first letter of s.
+func exported(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+func defaultOutput(types []supportedTypes, builtin bool) string {
+	if builtin {
+		return "ptr_gen.go"
+	}
+	dataType := types[0].dataType
+	return strings.ToLower(dataType[strings.LastIndex(dataType, ".")+1:]) + "_ptr.go"
+}
+
+func testFileName(output string) string {
+	return strings.TrimSuffix(output, ".go") + "_test.go"
+}
+
+func outputPackage(output string) string {
+	dir, err := filepath.Abs(filepath.Dir(output))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(dir)
+}
+
+// importName guesses the package name of an import path, skipping major version suffixes.
+// The import is aliased to it whenever it differs from the last path element.
+func importName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+	name, _, _ = strings.Cut(name, ".v")
+	name = strings.TrimPrefix(name, "go-")
+	return strings.NewReplacer("-", "_", ".", "_").Replace(name)
+}
+
+// importSpec renders an import line, aliased when name differs from the last path element.
+func importSpec(importPath, name string) string {
+	if name == path.Base(importPath) {
+		return fmt.Sprintf("%q", importPath)
+	}
+	return fmt.Sprintf("%s %q", name, importPath)
+}
+
+func parseTypes(typeList, importPath string) ([]supportedTypes, error) {
+	var types []supportedTypes
+	for _, entry := range strings.Split(typeList, ",") {
+		dataType, name, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			name = dataType
+			// wrappers of an output package type can't share its name
+			if importPath == "" {
+				name += "Ptr"
+			}
+		}
+		if dataType == "" || name == "" {
+			return nil, fmt.Errorf("empty type in '%s'", typeList)
+		}
+		if importPath == "" && exported(name) == dataType {
+			return nil, fmt.Errorf("wrappers of '%s' would redeclare the type, name them with %s:Name", dataType, dataType)
+		}
+		if importPath != "" {
+			dataType = importName(importPath) + "." + dataType
+		}
+		types = append(types, supportedTypes{name, dataType, importPath, nil, "", ""})
+	}
+	return types, nil
+}
+
+// typeImports returns the import lines for types and extra paths, standard
+// library first with an empty line before the others.
+func typeImports(types []supportedTypes, extra ...string) []string {
+	paths := slices.Clone(extra)
+	for _, t := range types {
+		if t.importPath != "" && !slices.Contains(paths, t.importPath) {
+			paths = append(paths, t.importPath)
+		}
+	}
+	slices.Sort(paths)
+	var std, others []string
+	for _, p := range paths {
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			others = append(others, importSpec(p, importName(p)))
+		} else {
+			std = append(std, importSpec(p, importName(p)))
+		}
+	}
+	if len(std) > 0 && len(others) > 0 {
+		std = append(std, "")
+	}
+	return append(std, others...)
+}
+
+func wrapperTypes(types []supportedTypes) []wrapperType {
+	wrappers := make([]wrapperType, len(types))
+	for i, t := range types {
+		wrappers[i] = wrapperType{Name: t.funcName(), Type: t.dataType, Samples: t.samples, NonZero: t.nonZero, IsZero: t.isZero}
+	}
+	return wrappers
+}
+
+func genWrappers(types []supportedTypes, pkg, fileName string, builtin, check bool) error {
+	file := wrappersFile{Package: pkg, Parse: builtin, Types: wrapperTypes(types)}
+	if pkg == "ptr" {
+		file.Imports = typeImports(types)
+	} else {
+		file.Qualifier = "ptr."
+		file.Imports = typeImports(types, ptrImportPath)
+	}
+	return generate(fileName, wrappersTemplate, file, check)
+}
+
+func genTests(types []supportedTypes, pkg, fileName string, builtin, check bool) error {
+	file := wrappersFile{Package: pkg, Types: wrapperTypes(types)}
+	if builtin {
+		var tested []supportedTypes
+		for _, t := range types {
+			if t.samples != nil {
+				tested = append(tested, t)
+			}
+		}
+		file.Imports = typeImports(tested, "testing", "github.com/stretchr/testify/assert", "github.com/stretchr/testify/require")
+		return generate(fileName, builtinTestsTemplate, file, check)
+	}
+	file.Imports = typeImports(types, "reflect", "testing")
+	return generate(fileName, customTestsTemplate, file, check)
+}
+
+// generate executes tmpl and writes the gofmt'd result to fileName, or with check
+// compares it to the current content of fileName.
+func generate(fileName string, tmpl *template.Template, data any, check bool) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("unable to execute template: %v", err)
+	}
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("unable to format generated code: %v", err)
+	}
+
+	if check {
+		current, err := os.ReadFile(fileName)
+		if err != nil {
+			return fmt.Errorf("unable to read generated file '%s': %v", fileName, err)
+		}
+		if !bytes.Equal(current, code) {
+			return fmt.Errorf("%s: %w", fileName, errStale)
+		}
+		return nil
+	}
+
+	err = os.WriteFile(fileName, code, 0o644)
+	if err != nil {
+		return fmt.Errorf("unable to write generated code to '%s': %v", fileName, err)
+	}
+	return nil
+}
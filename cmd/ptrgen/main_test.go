@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuiltinUpToDate(t *testing.T) {
+	err := genWrappers(builtinTypes, "ptr", "../../ptr_gen.go", true, true)
+	assert.NoError(t, err)
+
+	err = genTests(builtinTypes, "ptr", "../../ptr_gen_test.go", true, true)
+	assert.NoError(t, err)
+
+	err = genSQLNulls("../../sql_gen.go", true, true)
+	assert.NoError(t, err)
+}
+
+func Test_ParseTypes(t *testing.T) {
+	types, err := parseTypes("UUID, NullUUID:NullID", "github.com/google/uuid")
+	require.NoError(t, err)
+	assert.Equal(t, []supportedTypes{
+		{"UUID", "uuid.UUID", "github.com/google/uuid", nil, "", ""},
+		{"NullID", "uuid.NullUUID", "github.com/google/uuid", nil, "", ""},
+	}, types)
+
+	types, err = parseTypes("ID,Status:StatusP", "")
+	require.NoError(t, err)
+	assert.Equal(t, "IDPtr", types[0].funcName())
+	assert.Equal(t, "StatusP", types[1].funcName())
+
+	_, err = parseTypes("ID:ID", "")
+	assert.EqualError(t, err, "wrappers of 'ID' would redeclare the type, name them with ID:Name")
+	_, err = parseTypes("ID,", "")
+	assert.Error(t, err)
+}
+
+func Test_ImportName(t *testing.T) {
+	assert.Equal(t, "uuid", importName("github.com/google/uuid"))
+	assert.Equal(t, "decimal", importName("github.com/shopspring/decimal"))
+	assert.Equal(t, "chi", importName("github.com/go-chi/chi/v5"))
+	assert.Equal(t, "yaml", importName("gopkg.in/yaml.v3"))
+	assert.Equal(t, "netip", importName("net/netip"))
+}
+
+func Test_READMEListsBuiltinTypes(t *testing.T) {
+	readme, err := os.ReadFile("../../README.md")
+	require.NoError(t, err)
+
+	_, list, found := strings.Cut(string(readme), "It offers out of the box type wrappers for:\n")
+	require.True(t, found, "README type wrappers list not found")
+	list, _, _ = strings.Cut(list, "\n\n")
+
+	var listed []string
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimPrefix(line, "- ")
+		for _, part := range strings.Split(line, ", ") {
+			listed = append(listed, strings.Split(part, " and ")...)
+		}
+	}
+
+	var generated []string
+	for _, t := range builtinTypes {
+		generated = append(generated, t.dataType)
+	}
+	assert.ElementsMatch(t, generated, listed)
+}
@@ -0,0 +1,59 @@
+package main
+
+import "fmt"
+
+// sqlNullType is a legacy database/sql NullX type bridged to *T by the builtin wrappers.
+type sqlNullType struct {
+	Name    string
+	Type    string
+	Field   string
+	Samples []string
+}
+
+type sqlNullFile struct {
+	Package string
+	Imports []string
+	Types   []sqlNullType
+}
+
+// sqlNullTypes returns every legacy NullX type of database/sql,
+// borrowing the test samples of the builtin wrappers.
+func sqlNullTypes() ([]sqlNullType, error) {
+	nulls := []sqlNullType{
+		{Name: "String", Type: "string", Field: "String"},
+		{Name: "Int64", Type: "int64", Field: "Int64"},
+		{Name: "Int32", Type: "int32", Field: "Int32"},
+		{Name: "Int16", Type: "int16", Field: "Int16"},
+		{Name: "Byte", Type: "byte", Field: "Byte"},
+		{Name: "Float64", Type: "float64", Field: "Float64"},
+		{Name: "Bool", Type: "bool", Field: "Bool"},
+		{Name: "Time", Type: "time.Time", Field: "Time"},
+	}
+	for i, n := range nulls {
+		for _, t := range builtinTypes {
+			if t.dataType == n.Type {
+				nulls[i].Samples = t.samples
+			}
+		}
+		if nulls[i].Samples == nil {
+			return nil, fmt.Errorf("no builtin type %s for sql.Null%s", n.Type, n.Name)
+		}
+	}
+	return nulls, nil
+}
+
+func genSQLNulls(fileName string, tests, check bool) error {
+	nulls, err := sqlNullTypes()
+	if err != nil {
+		return err
+	}
+	file := sqlNullFile{Package: "ptr", Imports: []string{`"database/sql"`, `"time"`}, Types: nulls}
+	if err := generate(fileName, sqlNullsTemplate, file, check); err != nil {
+		return err
+	}
+	if !tests {
+		return nil
+	}
+	file.Imports = typeImports(nil, "database/sql", "testing", "time", "github.com/stretchr/testify/assert")
+	return generate(testFileName(fileName), sqlNullsTestsTemplate, file, check)
+}
@@ -0,0 +1,441 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/build"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type structsFile struct {
+	Package string
+	Imports []string
+	Funcs   []structsFunc
+}
+
+type structsFunc struct {
+	Name   string
+	Src    string
+	Dst    string
+	Fields []structsField
+}
+
+type structsField struct {
+	Name string
+	Expr string
+}
+
+type structPair struct {
+	a, b *types.Named
+}
+
+// structsGenerator writes reflection free converters between pairs of structs,
+// the compile time counterpart of ptr.StructToValues and ptr.StructToPointers.
+type structsGenerator struct {
+	pkgPath string
+	imports map[string]string // path -> name
+	names   map[string]string // name -> path
+	pairs   []structPair
+}
+
+func genStructs(from, to, output, pkgName string, check bool) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	imp := importerFrom()
+
+	src, err := lookupStruct(imp, wd, from)
+	if err != nil {
+		return err
+	}
+	dst, err := lookupStruct(imp, wd, to)
+	if err != nil {
+		return err
+	}
+
+	g := &structsGenerator{
+		pkgPath: outputPkgPath(wd, output, src, dst),
+		imports: map[string]string{},
+		names:   map[string]string{},
+	}
+	if pkgName == "" {
+		pkgName = filepath.Base(filepath.Dir(filepath.Join(wd, output)))
+	}
+
+	file := structsFile{Package: pkgName}
+	g.pairs = append(g.pairs, structPair{src, dst})
+	for i := 0; i < len(g.pairs); i++ {
+		pair := g.pairs[i]
+		for _, dir := range [][2]*types.Named{{pair.a, pair.b}, {pair.b, pair.a}} {
+			fn, err := g.structFunc(dir[0], dir[1])
+			if err != nil {
+				return err
+			}
+			file.Funcs = append(file.Funcs, fn)
+		}
+	}
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		file.Imports = append(file.Imports, importSpec(path, g.imports[path]))
+	}
+
+	return generate(output, structsTemplate, file, check)
+}
+
+// importerFrom type checks packages from source, as the generator runs before they build.
+func importerFrom() types.ImporterFrom {
+	return importer.ForCompiler(token.NewFileSet(), "source", nil).(types.ImporterFrom)
+}
+
+// lookupStruct loads a named struct type given as "import/path.Type".
+func lookupStruct(imp types.ImporterFrom, wd, qualified string) (*types.Named, error) {
+	dot := strings.LastIndex(qualified, ".")
+	if dot <= strings.LastIndex(qualified, "/") {
+		return nil, fmt.Errorf("invalid type '%s', expected import/path.Type", qualified)
+	}
+	path, name := qualified[:dot], qualified[dot+1:]
+	pkg, err := imp.ImportFrom(path, wd, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load package '%s': %v", path, err)
+	}
+	obj := pkg.Scope().Lookup(name)
+	if obj == nil {
+		return nil, fmt.Errorf("type '%s' not found in package '%s'", name, path)
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a named type", qualified)
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, fmt.Errorf("'%s' is not a struct", qualified)
+	}
+	return named, nil
+}
+
+// outputPkgPath returns the import path of the package the output file belongs to
+// when it is the package of one of the converted types, so those are not imported.
+func outputPkgPath(wd, output string, named ...*types.Named) string {
+	dir, err := filepath.Abs(filepath.Dir(filepath.Join(wd, output)))
+	if err != nil {
+		return ""
+	}
+	for _, t := range named {
+		path := t.Obj().Pkg().Path()
+		pkg, err := build.Import(path, wd, build.FindOnly)
+		if err == nil && pkg.Dir == dir {
+			return path
+		}
+	}
+	return ""
+}
+
+func (g *structsGenerator) qualifier(pkg *types.Package) string {
+	if pkg.Path() == g.pkgPath {
+		return ""
+	}
+	return g.importName(pkg.Path(), pkg.Name())
+}
+
+func (g *structsGenerator) importName(path, name string) string {
+	if n, ok := g.imports[path]; ok {
+		return n
+	}
+	alias := name
+	for i := 2; g.names[alias] != ""; i++ {
+		alias = name + strconv.Itoa(i)
+	}
+	g.imports[path] = alias
+	g.names[alias] = path
+	return alias
+}
+
+func (g *structsGenerator) typeString(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+func (g *structsGenerator) ptrFunc(name string) string {
+	if g.pkgPath == ptrImportPath {
+		return name
+	}
+	return g.importName(ptrImportPath, "ptr") + "." + name
+}
+
+func (g *structsGenerator) funcName(src, dst *types.Named) string {
+	srcName, dstName := src.Obj().Name(), dst.Obj().Name()
+	if srcName == dstName {
+		srcName = exported(src.Obj().Pkg().Name()) + srcName
+		dstName = exported(dst.Obj().Pkg().Name()) + dstName
+	}
+	return srcName + "To" + dstName
+}
+
+// pairFunc returns the converter name for a nested struct pair, registering the pair.
+func (g *structsGenerator) pairFunc(src, dst *types.Named) string {
+	for _, p := range g.pairs {
+		if types.Identical(p.a, src) && types.Identical(p.b, dst) || types.Identical(p.a, dst) && types.Identical(p.b, src) {
+			return g.funcName(src, dst)
+		}
+	}
+	g.pairs = append(g.pairs, structPair{src, dst})
+	return g.funcName(src, dst)
+}
+
+type structTag struct {
+	name    string
+	skip    bool
+	omitNil bool
+	def     *string
+}
+
+func parseStructTag(v *types.Var, tag string) structTag {
+	t := structTag{name: v.Name()}
+	value, ok := reflect.StructTag(tag).Lookup("ptr")
+	if !ok {
+		return t
+	}
+	if value == "-" {
+		t.skip = true
+		return t
+	}
+	name, opts, _ := strings.Cut(value, ",")
+	if name != "" && name != "secret" {
+		t.name = name
+	}
+	for opts != "" {
+		if def, ok := strings.CutPrefix(opts, "default="); ok {
+			t.def = &def
+			break
+		}
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == "omitnil" {
+			t.omitNil = true
+		}
+	}
+	return t
+}
+
+type structField struct {
+	v   *types.Var
+	tag structTag
+}
+
+func exportedFields(t *types.Named) []structField {
+	s := t.Underlying().(*types.Struct)
+	var fields []structField
+	for i := 0; i < s.NumFields(); i++ {
+		v := s.Field(i)
+		if !v.Exported() {
+			continue
+		}
+		tag := parseStructTag(v, s.Tag(i))
+		if tag.skip {
+			continue
+		}
+		fields = append(fields, structField{v, tag})
+	}
+	return fields
+}
+
+func (g *structsGenerator) structFunc(src, dst *types.Named) (structsFunc, error) {
+	fn := structsFunc{
+		Name: g.funcName(src, dst),
+		Src:  g.typeString(src),
+		Dst:  g.typeString(dst),
+	}
+	srcFields := map[string]structField{}
+	for _, f := range exportedFields(src) {
+		srcFields[f.tag.name] = f
+	}
+	for _, df := range exportedFields(dst) {
+		sf, ok := srcFields[df.tag.name]
+		if !ok {
+			continue
+		}
+		tag := df.tag
+		tag.omitNil = tag.omitNil || sf.tag.omitNil
+		if tag.def == nil {
+			tag.def = sf.tag.def
+		}
+		expr, err := g.convertExpr("src."+sf.v.Name(), sf.v.Type(), df.v.Type(), tag)
+		if err != nil {
+			return fn, fmt.Errorf("unable to convert field %s.%s to %s.%s: %v", src.Obj().Name(), sf.v.Name(), dst.Obj().Name(), df.v.Name(), err)
+		}
+		fn.Fields = append(fn.Fields, structsField{Name: df.v.Name(), Expr: expr})
+	}
+	return fn, nil
+}
+
+// convertExpr returns the expression converting expr from src to dst. Like
+// ptr.StructToValues, default= replaces a nil src pointer, or a zero src value
+// converted to a pointer, which omitnil turns into a nil pointer instead.
+func (g *structsGenerator) convertExpr(expr string, src, dst types.Type, tag structTag) (string, error) {
+	srcPtr, srcIsPtr := src.(*types.Pointer)
+	dstPtr, dstIsPtr := dst.(*types.Pointer)
+	if types.Identical(src, dst) {
+		if srcIsPtr && tag.def != nil {
+			lit, err := g.defaultLiteral(srcPtr.Elem(), *tag.def)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s(%s, %s[%s](%s))", g.ptrFunc("Coalesce"), expr, g.ptrFunc("To"), g.typeString(srcPtr.Elem()), lit), nil
+		}
+		return expr, nil
+	}
+	switch {
+	case srcIsPtr && !dstIsPtr:
+		if types.Identical(srcPtr.Elem(), dst) {
+			if tag.def != nil {
+				lit, err := g.defaultLiteral(dst, *tag.def)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("%s(%s, %s)", g.ptrFunc("ValueOr"), expr, lit), nil
+			}
+			return fmt.Sprintf("%s(%s)", g.ptrFunc("Value"), expr), nil
+		}
+		if f, ok := g.structPairFunc(srcPtr.Elem(), dst); ok {
+			return fmt.Sprintf("%s(%s(%s))", f, g.ptrFunc("Value"), expr), nil
+		}
+	case !srcIsPtr && dstIsPtr:
+		if types.Identical(src, dstPtr.Elem()) {
+			if tag.def == nil && !tag.omitNil {
+				return fmt.Sprintf("%s(%s)", g.ptrFunc("To"), expr), nil
+			}
+			if !types.Comparable(src) {
+				return "", fmt.Errorf("default= and omitnil need a comparable type, not %s", g.typeString(src))
+			}
+			nonZero := fmt.Sprintf("%s(%s)", g.ptrFunc("ToNonZero"), expr)
+			if tag.def == nil {
+				return nonZero, nil
+			}
+			lit, err := g.defaultLiteral(src, *tag.def)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%s(%s(%s, %s))", g.ptrFunc("To"), g.ptrFunc("ValueOr"), nonZero, lit), nil
+		}
+		if f, ok := g.structPairFunc(src, dstPtr.Elem()); ok {
+			return fmt.Sprintf("%s(%s(%s))", g.ptrFunc("To"), f, expr), nil
+		}
+	case srcIsPtr && dstIsPtr:
+		if f, ok := g.structPairFunc(srcPtr.Elem(), dstPtr.Elem()); ok {
+			return fmt.Sprintf("%s(%s, %s)", g.ptrFunc("Map"), expr, f), nil
+		}
+	default:
+		if f, ok := g.structPairFunc(src, dst); ok {
+			return fmt.Sprintf("%s(%s)", f, expr), nil
+		}
+	}
+
+	switch s := src.Underlying().(type) {
+	case *types.Slice:
+		d, ok := dst.Underlying().(*types.Slice)
+		if !ok {
+			break
+		}
+		if p, ok := s.Elem().(*types.Pointer); ok && types.Identical(p.Elem(), d.Elem()) {
+			return fmt.Sprintf("%s(%s)", g.ptrFunc("ValueSlice"), expr), nil
+		}
+		if p, ok := d.Elem().(*types.Pointer); ok && types.Identical(s.Elem(), p.Elem()) {
+			return fmt.Sprintf("%s(%s)", g.ptrFunc("ToSlice"), expr), nil
+		}
+	case *types.Map:
+		d, ok := dst.Underlying().(*types.Map)
+		if !ok || !types.Identical(s.Key(), d.Key()) {
+			break
+		}
+		if p, ok := s.Elem().(*types.Pointer); ok && types.Identical(p.Elem(), d.Elem()) {
+			return fmt.Sprintf("%s(%s)", g.ptrFunc("ValueMap"), expr), nil
+		}
+		if p, ok := d.Elem().(*types.Pointer); ok && types.Identical(s.Elem(), p.Elem()) {
+			return fmt.Sprintf("%s(%s)", g.ptrFunc("ToMap"), expr), nil
+		}
+	}
+	return "", fmt.Errorf("unsupported conversion from %s to %s", g.typeString(src), g.typeString(dst))
+}
+
+// structPairFunc returns the converter for two distinct named struct types.
+func (g *structsGenerator) structPairFunc(src, dst types.Type) (string, bool) {
+	s, ok := src.(*types.Named)
+	if !ok {
+		return "", false
+	}
+	d, ok := dst.(*types.Named)
+	if !ok {
+		return "", false
+	}
+	if _, ok := s.Underlying().(*types.Struct); !ok {
+		return "", false
+	}
+	if _, ok := d.Underlying().(*types.Struct); !ok {
+		return "", false
+	}
+	return g.pairFunc(s, d), true
+}
+
+// defaultLiteral turns a `ptr:",default=..."` value into a Go constant of type t,
+// parsed the way ptr.StructToValues parses it for the types both support.
+func (g *structsGenerator) defaultLiteral(t types.Type, def string) (string, error) {
+	if types.TypeString(t, nil) == "time.Duration" {
+		d, err := time.ParseDuration(def)
+		if err != nil {
+			return "", fmt.Errorf("invalid default value '%s' for %s: %v", def, g.typeString(t), err)
+		}
+		return fmt.Sprintf("%s(%d)", g.typeString(t), d), nil
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return "", fmt.Errorf("default value is not supported for %s", g.typeString(t))
+	}
+	info := basic.Info()
+	bits := int(types.SizesFor("gc", "amd64").Sizeof(basic) * 8)
+	var (
+		lit string
+		err error
+	)
+	switch {
+	case info&types.IsString != 0:
+		lit = strconv.Quote(def)
+	case info&types.IsBoolean != 0:
+		var b bool
+		b, err = strconv.ParseBool(def)
+		lit = strconv.FormatBool(b)
+	case info&types.IsUnsigned != 0:
+		var u uint64
+		u, err = strconv.ParseUint(def, 10, bits)
+		lit = strconv.FormatUint(u, 10)
+	case info&types.IsInteger != 0:
+		var i int64
+		i, err = strconv.ParseInt(def, 10, bits)
+		lit = strconv.FormatInt(i, 10)
+	case info&types.IsFloat != 0:
+		var f float64
+		f, err = strconv.ParseFloat(def, bits)
+		if err == nil && (math.IsInf(f, 0) || math.IsNaN(f)) {
+			err = errors.New("not a finite number")
+		}
+		lit = strconv.FormatFloat(f, 'g', -1, 64)
+	default:
+		return "", fmt.Errorf("default value is not supported for %s", g.typeString(t))
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid default value '%s' for %s: %v", def, g.typeString(t), err)
+	}
+	return lit, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_GenStructs generates the converters of the testdata/structs fixture pair
+// into a module of its own, then runs them against the reflection based converters.
+func Test_GenStructs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a module")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	root, err := filepath.Abs("../..")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS("testdata/structs")))
+	goMod := "module fixture\n\ngo 1.24\n\nrequire github.com/sougiovn/ptr v0.0.0\n\nreplace github.com/sougiovn/ptr => " + root + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644))
+	goSum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0o644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "mapper"), 0o755))
+
+	t.Setenv("GOFLAGS", "-mod=mod")
+	t.Setenv("GOPROXY", "off")
+	t.Setenv("GOWORK", "off")
+	t.Chdir(dir)
+
+	err = genStructs("fixture/sdk.User", "fixture/domain.User", "mapper/user_gen.go", "", false)
+	require.NoError(t, err)
+	err = genStructs("fixture/sdk.User", "fixture/domain.User", "mapper/user_gen.go", "", true)
+	require.NoError(t, err, "check mode must accept the file it just generated")
+
+	out, err := exec.Command(goBin, "run", ".").CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.Equal(t, "ok\n", string(out))
+}
+
+func Test_DefaultLiteral(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS("testdata/structs")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module fixture\n\ngo 1.24\n"), 0o644))
+	t.Setenv("GOFLAGS", "-mod=mod")
+	t.Setenv("GOPROXY", "off")
+	t.Setenv("GOWORK", "off")
+	t.Chdir(dir)
+
+	g := &structsGenerator{imports: map[string]string{}, names: map[string]string{}}
+	user, err := lookupStruct(importerFrom(), dir, "fixture/domain.User")
+	require.NoError(t, err)
+	fields := map[string]structField{}
+	for _, f := range exportedFields(user) {
+		fields[f.v.Name()] = f
+	}
+
+	for field, want := range map[string]string{
+		"Role":    `"viewer"`,
+		"Timeout": "time.Duration(30000000000)",
+		"Retries": "3",
+	} {
+		lit, err := g.defaultLiteral(fields[field].v.Type(), *fields[field].tag.def)
+		require.NoError(t, err)
+		assert.Equal(t, want, lit, field)
+	}
+
+	_, err = g.defaultLiteral(fields["Retries"].v.Type(), "300")
+	assert.ErrorContains(t, err, "invalid default value '300' for int8")
+	_, err = g.defaultLiteral(fields["Age"].v.Type(), "0x10")
+	assert.ErrorContains(t, err, "invalid default value '0x10' for int64")
+}
@@ -0,0 +1,461 @@
+package main
+
+import "text/template"
+
+const codeGenHeader = "// Code generated by ptrgen; DO NOT EDIT."
+
+var templateFuncs = template.FuncMap{
+	"header": func() string { return codeGenHeader },
+}
+
+var wrappersTemplate = template.Must(template.New("wrappers").Funcs(templateFuncs).Parse(`{{header}}
+
+package {{.Package}}
+{{if .Imports}}
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{end}}
+{{- $q := .Qualifier}}
+{{- range .Types}}
+func {{.Name}}(v {{.Type}}) *{{.Type}} {
+	return {{$q}}To(v)
+}
+
+func {{.Name}}Slice(v []{{.Type}}) []*{{.Type}} {
+	return {{$q}}ToSlice(v)
+}
+
+func {{.Name}}Map(v map[string]{{.Type}}) map[string]*{{.Type}} {
+	return {{$q}}ToMap(v)
+}
+
+func {{.Name}}MapOf[K comparable](v map[K]{{.Type}}) map[K]*{{.Type}} {
+	return {{$q}}ToMap(v)
+}
+
+func {{.Name}}Value(v *{{.Type}}) {{.Type}} {
+	return {{$q}}Value(v)
+}
+
+func {{.Name}}ValueSlice(v []*{{.Type}}) []{{.Type}} {
+	return {{$q}}ValueSlice(v)
+}
+
+func {{.Name}}ValueMap(v map[string]*{{.Type}}) map[string]{{.Type}} {
+	return {{$q}}ValueMap(v)
+}
+
+func {{.Name}}ValueMapOf[K comparable](v map[K]*{{.Type}}) map[K]{{.Type}} {
+	return {{$q}}ValueMap(v)
+}
+
+func {{.Name}}ValueOr(v *{{.Type}}, def {{.Type}}) {{.Type}} {
+	return {{$q}}ValueOr(v, def)
+}
+
+func {{.Name}}ValueSliceOr(v []*{{.Type}}, def {{.Type}}) []{{.Type}} {
+	return {{$q}}ValueSliceOr(v, def)
+}
+
+func {{.Name}}ValueMapOr(v map[string]*{{.Type}}, def {{.Type}}) map[string]{{.Type}} {
+	return {{$q}}ValueMapOr(v, def)
+}
+
+func {{.Name}}ValueMapOrOf[K comparable](v map[K]*{{.Type}}, def {{.Type}}) map[K]{{.Type}} {
+	return {{$q}}ValueMapOr(v, def)
+}
+
+func {{.Name}}ValueSliceWith(v []*{{.Type}}, opts ...{{$q}}NilOption[{{.Type}}]) ([]{{.Type}}, error) {
+	return {{$q}}ValueSliceWith(v, opts...)
+}
+
+func {{.Name}}ValueMapWith(v map[string]*{{.Type}}, opts ...{{$q}}NilOption[{{.Type}}]) (map[string]{{.Type}}, error) {
+	return {{$q}}ValueMapWith(v, opts...)
+}
+
+func {{.Name}}ValueMapWithOf[K comparable](v map[K]*{{.Type}}, opts ...{{$q}}NilOption[{{.Type}}]) (map[K]{{.Type}}, error) {
+	return {{$q}}ValueMapWith(v, opts...)
+}
+{{- if .NonZero}}
+{{- if .IsZero}}
+
+func To{{.Name}}{{.NonZero}}(v {{.Type}}) *{{.Type}} {
+	return {{$q}}ToNonZeroFunc(v, {{.IsZero}})
+}
+
+func To{{.Name}}Slice{{.NonZero}}(v []{{.Type}}) []*{{.Type}} {
+	return {{$q}}ToSliceNonZeroFunc(v, {{.IsZero}})
+}
+
+func To{{.Name}}Map{{.NonZero}}(v map[string]{{.Type}}) map[string]*{{.Type}} {
+	return {{$q}}ToMapNonZeroFunc(v, {{.IsZero}})
+}
+
+func To{{.Name}}Map{{.NonZero}}Of[K comparable](v map[K]{{.Type}}) map[K]*{{.Type}} {
+	return {{$q}}ToMapNonZeroFunc(v, {{.IsZero}})
+}
+{{- else}}
+
+func To{{.Name}}{{.NonZero}}(v {{.Type}}) *{{.Type}} {
+	return {{$q}}ToNonZero(v)
+}
+
+func To{{.Name}}Slice{{.NonZero}}(v []{{.Type}}) []*{{.Type}} {
+	return {{$q}}ToSliceNonZero(v)
+}
+
+func To{{.Name}}Map{{.NonZero}}(v map[string]{{.Type}}) map[string]*{{.Type}} {
+	return {{$q}}ToMapNonZero(v)
+}
+
+func To{{.Name}}Map{{.NonZero}}Of[K comparable](v map[K]{{.Type}}) map[K]*{{.Type}} {
+	return {{$q}}ToMapNonZero(v)
+}
+{{- end}}
+{{- end}}
+{{- if $.Parse}}
+
+func Parse{{.Name}}(s string, opts ...{{$q}}ParseOption) (*{{.Type}}, error) {
+	return {{$q}}Parse[{{.Type}}](s, opts...)
+}
+{{- end}}
+{{end}}`))
+
+// builtinTestsTemplate generates testify based tests for the types with samples,
+// it is used for the ptr package itself.
+var builtinTestsTemplate = template.Must(template.New("builtinTests").Funcs(templateFuncs).Parse(`{{header}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{- range .Types}}{{if .Samples}}
+{{- $s0 := index .Samples 0}}{{$s1 := index .Samples 1}}{{$s2 := index .Samples 2}}
+
+func Test_{{.Name}}(t *testing.T) {
+	t.Run("{{.Type}}", func(t *testing.T) {
+		value := {{$s0}}
+
+		pointer := {{.Name}}(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("{{.Type}}/slice", func(t *testing.T) {
+		value := []{{.Type}}{ {{- $s0}}, {{$s1}}, {{$s2 -}} }
+
+		pointer := {{.Name}}Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("{{.Type}}/map", func(t *testing.T) {
+		value := map[string]{{.Type}}{
+			"foo": {{$s0}},
+			"bar": {{$s1}},
+			"baz": {{$s2}},
+		}
+
+		pointer := {{.Name}}Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("{{.Type}}/map/int64", func(t *testing.T) {
+		value := map[int64]{{.Type}}{
+			42: {{$s0}},
+			69: {{$s1}},
+		}
+
+		pointer := {{.Name}}MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_{{.Name}}Value(t *testing.T) {
+	t.Run("{{.Type}}", func(t *testing.T) {
+		pointer := {{$s0}}
+
+		value := {{.Name}}Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("{{.Type}}/slice", func(t *testing.T) {
+		p1 := {{$s0}}
+		p2 := {{$s1}}
+		p3 := {{$s2}}
+		pointer := []*{{.Type}}{&p1, &p2, &p3}
+
+		value := {{.Name}}ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("{{.Type}}/map", func(t *testing.T) {
+		p1 := {{$s0}}
+		p2 := {{$s1}}
+		p3 := {{$s2}}
+		pointer := map[string]*{{.Type}}{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := {{.Name}}ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("{{.Type}}/map/int64", func(t *testing.T) {
+		p1 := {{$s0}}
+		p2 := {{$s1}}
+		pointer := map[int64]*{{.Type}}{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := {{.Name}}ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_{{.Name}}ValueOr(t *testing.T) {
+	t.Run("{{.Type}}", func(t *testing.T) {
+		pointer := {{$s0}}
+
+		assert.Equal(t, pointer, {{.Name}}ValueOr(&pointer, {{$s1}}))
+		assert.Equal(t, {{$s1}}, {{.Name}}ValueOr(nil, {{$s1}}))
+	})
+
+	t.Run("{{.Type}}/slice", func(t *testing.T) {
+		p1 := {{$s0}}
+		pointer := []*{{.Type}}{&p1, nil}
+
+		value := {{.Name}}ValueSliceOr(pointer, {{$s1}})
+		assert.Equal(t, []{{.Type}}{ {{- $s0}}, {{$s1 -}} }, value)
+	})
+
+	t.Run("{{.Type}}/map", func(t *testing.T) {
+		p1 := {{$s0}}
+		pointer := map[string]*{{.Type}}{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := {{.Name}}ValueMapOr(pointer, {{$s1}})
+		assert.Equal(t, map[string]{{.Type}}{"foo": {{$s0}}, "bar": {{$s1}}}, value)
+	})
+
+	t.Run("{{.Type}}/map/int64", func(t *testing.T) {
+		p1 := {{$s0}}
+		pointer := map[int64]*{{.Type}}{42: &p1, 69: nil}
+
+		value := {{.Name}}ValueMapOrOf(pointer, {{$s1}})
+		assert.Equal(t, map[int64]{{.Type}}{42: {{$s0}}, 69: {{$s1}}}, value)
+	})
+}
+
+func Test_{{.Name}}ValueWith(t *testing.T) {
+	t.Run("{{.Type}}/slice", func(t *testing.T) {
+		p1 := {{$s0}}
+		pointer := []*{{.Type}}{&p1, nil}
+
+		value, err := {{.Name}}ValueSliceWith(pointer, DefaultNil({{$s1}}))
+		require.NoError(t, err)
+		assert.Equal(t, []{{.Type}}{ {{- $s0}}, {{$s1 -}} }, value)
+	})
+
+	t.Run("{{.Type}}/map", func(t *testing.T) {
+		p1 := {{$s0}}
+		pointer := map[string]*{{.Type}}{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := {{.Name}}ValueMapWith(pointer, SkipNil[{{.Type}}]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]{{.Type}}{"foo": {{$s0}}}, value)
+	})
+
+	t.Run("{{.Type}}/map/int64", func(t *testing.T) {
+		p1 := {{$s0}}
+		pointer := map[int64]*{{.Type}}{42: &p1, 69: nil}
+
+		value, err := {{.Name}}ValueMapWithOf(pointer, SkipNil[{{.Type}}]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]{{.Type}}{42: {{$s0}}}, value)
+	})
+}
+{{- if .NonZero}}
+
+func Test_To{{.Name}}{{.NonZero}}(t *testing.T) {
+	var zero {{.Type}}
+
+	t.Run("{{.Type}}", func(t *testing.T) {
+		assert.Equal(t, {{$s0}}, *To{{.Name}}{{.NonZero}}({{$s0}}))
+		assert.Nil(t, To{{.Name}}{{.NonZero}}(zero))
+	})
+
+	t.Run("{{.Type}}/slice", func(t *testing.T) {
+		pointer := To{{.Name}}Slice{{.NonZero}}([]{{.Type}}{ {{- $s0}}, zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, {{$s0}}, *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("{{.Type}}/map", func(t *testing.T) {
+		pointer := To{{.Name}}Map{{.NonZero}}(map[string]{{.Type}}{"foo": {{$s0}}, "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, {{$s0}}, *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("{{.Type}}/map/int64", func(t *testing.T) {
+		pointer := To{{.Name}}Map{{.NonZero}}Of(map[int64]{{.Type}}{42: {{$s0}}, 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, {{$s0}}, *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+{{- end}}
+
+func Test_Parse{{.Name}}(t *testing.T) {
+	pointer, err := Parse{{.Name}}(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+{{- end}}{{end}}
+`))
+
+// customTestsTemplate generates standard library only tests around the zero value,
+// as user packages may not depend on testify and their types have no known literals.
+var customTestsTemplate = template.Must(template.New("customTests").Funcs(templateFuncs).Parse(`{{header}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{- range .Types}}
+
+func Test_{{.Name}}(t *testing.T) {
+	var value {{.Type}}
+
+	if got := {{.Name}}Value({{.Name}}(value)); !reflect.DeepEqual(got, value) {
+		t.Errorf("{{.Name}}Value({{.Name}}(%v)) = %v", value, got)
+	}
+	if got := {{.Name}}Value(nil); !reflect.DeepEqual(got, value) {
+		t.Errorf("{{.Name}}Value(nil) = %v, want zero value", got)
+	}
+	if got := {{.Name}}ValueOr(nil, value); !reflect.DeepEqual(got, value) {
+		t.Errorf("{{.Name}}ValueOr(nil, %v) = %v", value, got)
+	}
+
+	slice := []{{.Type}}{value, value}
+	if got := {{.Name}}ValueSlice({{.Name}}Slice(slice)); !reflect.DeepEqual(got, slice) {
+		t.Errorf("{{.Name}}ValueSlice({{.Name}}Slice(%v)) = %v", slice, got)
+	}
+
+	m := map[string]{{.Type}}{"foo": value}
+	if got := {{.Name}}ValueMap({{.Name}}Map(m)); !reflect.DeepEqual(got, m) {
+		t.Errorf("{{.Name}}ValueMap({{.Name}}Map(%v)) = %v", m, got)
+	}
+}
+{{- end}}
+`))
+
+var structsTemplate = template.Must(template.New("structs").Funcs(templateFuncs).Parse(`{{header}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{range .Funcs}}
+// {{.Name}} converts {{.Src}} to {{.Dst}}.
+func {{.Name}}(src {{.Src}}) {{.Dst}} {
+	return {{.Dst}}{
+{{- range .Fields}}
+		{{.Name}}: {{.Expr}},
+{{- end}}
+	}
+}
+{{end}}`))
+
+var sqlNullsTemplate = template.Must(template.New("sqlNulls").Funcs(templateFuncs).Parse(`{{header}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{range .Types}}
+// FromNull{{.Name}} returns a pointer to the value of v, or nil if v is not valid.
+func FromNull{{.Name}}(v sql.Null{{.Name}}) *{{.Type}} {
+	if !v.Valid {
+		return nil
+	}
+	return &v.{{.Field}}
+}
+
+// ToNull{{.Name}} returns a valid sql.Null{{.Name}} holding the value p points to, or an invalid one if p is nil.
+func ToNull{{.Name}}(p *{{.Type}}) sql.Null{{.Name}} {
+	if p == nil {
+		return sql.Null{{.Name}}{}
+	}
+	return sql.Null{{.Name}}{ {{- .Field}}: *p, Valid: true}
+}
+{{end}}`))
+
+var sqlNullsTestsTemplate = template.Must(template.New("sqlNullsTests").Funcs(templateFuncs).Parse(`{{header}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{- range .Types}}
+{{- $s0 := index .Samples 0}}
+
+func Test_Null{{.Name}}(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, {{$s0}}, *FromNull{{.Name}}(sql.Null{{.Name}}{ {{- .Field}}: {{$s0}}, Valid: true}))
+		assert.Nil(t, FromNull{{.Name}}(sql.Null{{.Name}}{ {{- .Field}}: {{$s0}}}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := {{$s0}}
+
+		assert.Equal(t, sql.Null{{.Name}}{ {{- .Field}}: {{$s0}}, Valid: true}, ToNull{{.Name}}(&value))
+		assert.Equal(t, sql.Null{{.Name}}{}, ToNull{{.Name}}(nil))
+	})
+}
+{{- end}}
+`))
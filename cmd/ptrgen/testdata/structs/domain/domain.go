@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+type Address struct {
+	Street string
+	Zip    string
+}
+
+type User struct {
+	Name     string
+	Age      int64 `ptr:",omitnil"`
+	Mail     string
+	Role     string        `ptr:",default=viewer"`
+	Timeout  time.Duration `ptr:",default=30s"`
+	Retries  int8          `ptr:",default=3"`
+	Nick     *string       `ptr:",default=anonymous"`
+	Address  Address
+	Tags     []string
+	Labels   map[string]string
+	Internal string
+}
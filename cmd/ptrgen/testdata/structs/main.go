@@ -0,0 +1,79 @@
+// Command structs checks the generated converters against the reflection based
+// ptr.StructToValues and ptr.StructToPointers, printing the mismatches.
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"fixture/domain"
+	"fixture/mapper"
+	"fixture/sdk"
+
+	"github.com/sougiovn/ptr"
+)
+
+func main() {
+	failed := false
+	check := func(name string, generated, reflected any) {
+		g := fmt.Sprintf("%+v", ptr.Formatter(generated))
+		r := fmt.Sprintf("%+v", ptr.Formatter(reflected))
+		if g != r {
+			fmt.Printf("%s:\n\tgenerated %s\n\treflected %s\n", name, g, r)
+			failed = true
+		}
+	}
+
+	for name, in := range map[string]sdk.User{
+		"empty": {},
+		"full": {
+			Name:     ptr.To("foo"),
+			Age:      ptr.To[int64](42),
+			Email:    ptr.To("foo@bar.baz"),
+			Role:     ptr.To("admin"),
+			Timeout:  ptr.To(time.Second),
+			Retries:  ptr.To[int8](1),
+			Nick:     ptr.To("f"),
+			Address:  &sdk.Address{Street: ptr.To("bar")},
+			Tags:     ptr.ToSlice([]string{"a", "b"}),
+			Labels:   ptr.ToMap(map[string]string{"x": "y"}),
+			Internal: ptr.To("internal"),
+		},
+	} {
+		var reflected domain.User
+		if err := ptr.StructToValues(&reflected, in); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		check("sdk to domain/"+name, mapper.SdkUserToDomainUser(in), reflected)
+	}
+
+	for name, in := range map[string]domain.User{
+		"empty": {},
+		"full": {
+			Name:    "foo",
+			Age:     42,
+			Mail:    "foo@bar.baz",
+			Role:    "admin",
+			Timeout: time.Second,
+			Retries: 1,
+			Nick:    ptr.To("f"),
+			Address: domain.Address{Street: "bar"},
+			Tags:    []string{"a", "b"},
+			Labels:  map[string]string{"x": "y"},
+		},
+	} {
+		var reflected sdk.User
+		if err := ptr.StructToPointers(&reflected, in); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		check("domain to sdk/"+name, mapper.DomainUserToSdkUser(in), reflected)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+	fmt.Println("ok")
+}
@@ -0,0 +1,22 @@
+package sdk
+
+import "time"
+
+type Address struct {
+	Street *string
+	Zip    *string
+}
+
+type User struct {
+	Name     *string
+	Age      *int64
+	Email    *string `ptr:"Mail"`
+	Role     *string
+	Timeout  *time.Duration
+	Retries  *int8
+	Nick     *string
+	Address  *Address
+	Tags     []*string
+	Labels   map[string]*string
+	Internal *string `ptr:"-"`
+}
@@ -0,0 +1,65 @@
+package ptr
+
+// Coalesce returns the first non-nil pointer of ps, or nil if they are all nil.
+//
+//	port := ptr.Coalesce(flagPort, envPort, filePort)
+func Coalesce[T any](ps ...*T) *T {
+	for _, p := range ps {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// CoalesceValue returns the value of the first non-nil pointer of ps,
+// or the zero value if they are all nil.
+func CoalesceValue[T any](ps ...*T) T {
+	return Value(Coalesce(ps...))
+}
+
+// Compact returns the non-nil pointers of p, in a new slice.
+func Compact[T any](p []*T) []*T {
+	c := make([]*T, 0, len(p)-CountNil(p...))
+	for _, v := range p {
+		if v != nil {
+			c = append(c, v)
+		}
+	}
+	return c
+}
+
+// CountNil returns how many pointers of ps are nil.
+func CountNil[T any](ps ...*T) int {
+	n := 0
+	for _, p := range ps {
+		if p == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// AllNil reports whether all the pointers of ps are nil, true when there are none.
+func AllNil[T any](ps ...*T) bool {
+	return CountNil(ps...) == len(ps)
+}
+
+// AnyNil reports whether any pointer of ps is nil.
+func AnyNil[T any](ps ...*T) bool {
+	for _, p := range ps {
+		if p == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// NonZero returns p, or nil if p points to the zero value.
+func NonZero[T comparable](p *T) *T {
+	var zero T
+	if p == nil || *p == zero {
+		return nil
+	}
+	return p
+}
@@ -0,0 +1,56 @@
+package ptr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Coalesce(t *testing.T) {
+	foo, bar := To("foo"), To("bar")
+
+	assert.Same(t, foo, Coalesce(nil, foo, bar))
+	assert.Same(t, bar, Coalesce(nil, nil, bar))
+	assert.Nil(t, Coalesce[string](nil, nil))
+	assert.Nil(t, Coalesce[string]())
+
+	assert.Equal(t, "foo", CoalesceValue(nil, foo, bar))
+	assert.Equal(t, "", CoalesceValue[string](nil))
+}
+
+func Test_Compact(t *testing.T) {
+	foo, bar := To("foo"), To("bar")
+
+	assert.Equal(t, []*string{foo, bar}, Compact([]*string{nil, foo, nil, bar}))
+	assert.Empty(t, Compact([]*string{nil}))
+	assert.Empty(t, Compact[string](nil))
+}
+
+func Test_CountNil(t *testing.T) {
+	foo := To("foo")
+
+	assert.Equal(t, 2, CountNil(nil, foo, nil))
+	assert.Equal(t, 0, CountNil(foo))
+	assert.Equal(t, 0, CountNil[string]())
+}
+
+func Test_AllNil(t *testing.T) {
+	assert.True(t, AllNil[int](nil, nil))
+	assert.True(t, AllNil[int]())
+	assert.False(t, AllNil(nil, To(42)))
+}
+
+func Test_AnyNil(t *testing.T) {
+	assert.True(t, AnyNil(To(42), nil))
+	assert.False(t, AnyNil(To(42), To(69)))
+	assert.False(t, AnyNil[int]())
+}
+
+func Test_NonZero(t *testing.T) {
+	p := To(42)
+
+	assert.Same(t, p, NonZero(p))
+	assert.Nil(t, NonZero(To(0)))
+	assert.Nil(t, NonZero(To("")))
+	assert.Nil(t, NonZero[int](nil))
+}
@@ -0,0 +1,79 @@
+package ptr
+
+import "cmp"
+
+// Equal reports whether a and b are both nil or point to equal values.
+func Equal[T comparable](a, b *T) bool {
+	return EqualFunc(a, b, func(a, b T) bool { return a == b })
+}
+
+// EqualFunc is like Equal using eq to compare the values.
+func EqualFunc[T any](a, b *T, eq func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return eq(*a, *b)
+}
+
+// EqualSlice reports whether a and b have the same length and Equal elements.
+func EqualSlice[T comparable](a, b []*T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualMap reports whether a and b have the same keys and Equal values.
+func EqualMap[K, T comparable](a, b map[K]*T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		vb, ok := b[k]
+		if !ok || !Equal(va, vb) {
+			return false
+		}
+	}
+	return true
+}
+
+// NilOrder tells where nil pointers sort relative to non-nil ones.
+type NilOrder uint8
+
+const (
+	NilFirst NilOrder = iota
+	NilLast
+)
+
+// Compare compares the values a and b point to like cmp.Compare, with nil
+// sorting before any value. It can be used with slices.SortFunc.
+func Compare[T cmp.Ordered](a, b *T) int {
+	return CompareFunc(NilFirst, cmp.Compare[T])(a, b)
+}
+
+// CompareFunc returns a comparison func for pointers, using cmp to compare
+// the values and order to place nil.
+//
+//	slices.SortFunc(s, ptr.CompareFunc(ptr.NilLast, strings.Compare))
+func CompareFunc[T any](order NilOrder, cmp func(T, T) int) func(a, b *T) int {
+	nilCmp := -1
+	if order == NilLast {
+		nilCmp = 1
+	}
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilCmp
+		case b == nil:
+			return -nilCmp
+		}
+		return cmp(*a, *b)
+	}
+}
@@ -0,0 +1,90 @@
+package ptr
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Equal(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		assert.True(t, Equal(To(42), To(42)))
+		assert.False(t, Equal(To(42), To(69)))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.True(t, Equal[int](nil, nil))
+		assert.False(t, Equal(nil, To(42)))
+		assert.False(t, Equal(To(42), nil))
+	})
+}
+
+func Test_EqualFunc(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		assert.True(t, EqualFunc(To("foo"), To("FOO"), strings.EqualFold))
+		assert.False(t, EqualFunc(To("foo"), To("bar"), strings.EqualFold))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.True(t, EqualFunc(nil, nil, strings.EqualFold))
+		assert.False(t, EqualFunc(nil, To("foo"), strings.EqualFold))
+	})
+}
+
+func Test_EqualSlice(t *testing.T) {
+	t.Run("string[]", func(t *testing.T) {
+		assert.True(t, EqualSlice([]*string{To("foo"), nil}, []*string{To("foo"), nil}))
+		assert.False(t, EqualSlice([]*string{To("foo"), nil}, []*string{To("foo"), To("bar")}))
+		assert.False(t, EqualSlice([]*string{To("foo")}, []*string{To("foo"), nil}))
+	})
+}
+
+func Test_EqualMap(t *testing.T) {
+	t.Run("[string]string", func(t *testing.T) {
+		a := map[string]*string{"foo": To("bar"), "baz": nil}
+
+		assert.True(t, EqualMap(a, map[string]*string{"foo": To("bar"), "baz": nil}))
+		assert.False(t, EqualMap(a, map[string]*string{"foo": To("bar"), "baz": To("qux")}))
+		assert.False(t, EqualMap(a, map[string]*string{"foo": To("bar"), "qux": nil}))
+		assert.False(t, EqualMap(a, map[string]*string{"foo": To("bar")}))
+	})
+}
+
+func Test_Compare(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		assert.Equal(t, -1, Compare(To(42), To(69)))
+		assert.Equal(t, 0, Compare(To(42), To(42)))
+		assert.Equal(t, 1, Compare(To(69), To(42)))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, 0, Compare[int](nil, nil))
+		assert.Equal(t, -1, Compare(nil, To(42)))
+		assert.Equal(t, 1, Compare(To(42), nil))
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		value := []*int{To(69), nil, To(42)}
+
+		slices.SortFunc(value, Compare)
+		assert.Equal(t, []*int{nil, To(42), To(69)}, value)
+	})
+}
+
+func Test_CompareFunc(t *testing.T) {
+	t.Run("nil first", func(t *testing.T) {
+		value := []*string{To("foo"), nil, To("bar")}
+
+		slices.SortFunc(value, CompareFunc(NilFirst, strings.Compare))
+		assert.Equal(t, []*string{nil, To("bar"), To("foo")}, value)
+	})
+
+	t.Run("nil last", func(t *testing.T) {
+		value := []*string{To("foo"), nil, To("bar"), nil}
+
+		slices.SortFunc(value, CompareFunc(NilLast, strings.Compare))
+		assert.Equal(t, []*string{To("bar"), To("foo"), nil, nil}, value)
+	})
+}
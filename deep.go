@@ -0,0 +1,203 @@
+package ptr
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// generic nested ptr
+func ToSliceSlice[T any](v [][]T) [][]*T {
+	p := make([][]*T, len(v))
+	for i := range v {
+		p[i] = ToSlice(v[i])
+	}
+	return p
+}
+
+func ToSliceMap[K comparable, T any](v []map[K]T) []map[K]*T {
+	p := make([]map[K]*T, len(v))
+	for i := range v {
+		p[i] = ToMap(v[i])
+	}
+	return p
+}
+
+func ToMapSlice[K comparable, T any](v map[K][]T) map[K][]*T {
+	p := make(map[K][]*T, len(v))
+	for k, v := range v {
+		p[k] = ToSlice(v)
+	}
+	return p
+}
+
+// generic nested value
+func ValueSliceSlice[T any](p [][]*T) [][]T {
+	v := make([][]T, len(p))
+	for i := range p {
+		v[i] = ValueSlice(p[i])
+	}
+	return v
+}
+
+func ValueSliceMap[K comparable, T any](p []map[K]*T) []map[K]T {
+	v := make([]map[K]T, len(p))
+	for i := range p {
+		v[i] = ValueMap(p[i])
+	}
+	return v
+}
+
+func ValueMapSlice[K comparable, T any](p map[K][]*T) map[K][]T {
+	v := make(map[K][]T, len(p))
+	for key, val := range p {
+		v[key] = ValueSlice(val)
+	}
+	return v
+}
+
+// reflection based nested ptr and value
+
+// ValueDeep converts v into T, walking nested slices, arrays, maps, structs,
+// pointer chains and interfaces, and dereferencing pointers wherever T holds a value.
+// Nil pointers become zero values, as with Value.
+//
+//	m, err := ptr.ValueDeep[map[string][]string](map[string][]*string{...})
+func ValueDeep[T any](v any) (T, error) {
+	if p, ok := v.(*T); ok {
+		return Value(p), nil
+	}
+	return convertDeep[T](v)
+}
+
+// ToDeep converts v into T, walking nested slices, arrays, maps, structs,
+// pointer chains and interfaces, and allocating pointers wherever T holds one.
+// Nil pointers in v stay nil.
+//
+//	m, err := ptr.ToDeep[[]map[string]*int64]([]map[string]int64{...})
+func ToDeep[T any](v any) (T, error) {
+	return convertDeep[T](v)
+}
+
+func convertDeep[T any](v any) (T, error) {
+	if t, ok := v.(T); ok {
+		return t, nil
+	}
+	var t T
+	err := deepConvert(reflect.ValueOf(&t).Elem(), reflect.ValueOf(v), "")
+	return t, err
+}
+
+// deepConvert sets dst, which must be settable, from src.
+func deepConvert(dst, src reflect.Value, path string) error {
+	if !src.IsValid() {
+		dst.SetZero()
+		return nil
+	}
+	if src.Type() == dst.Type() || dst.Kind() == reflect.Interface && src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	switch src.Kind() {
+	case reflect.Interface:
+		return deepConvert(dst, src.Elem(), path)
+	case reflect.Pointer:
+		if src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		if dst.Kind() != reflect.Pointer {
+			return deepConvert(dst, src.Elem(), path)
+		}
+		src = src.Elem()
+	}
+
+	switch dst.Kind() {
+	case reflect.Pointer:
+		p := reflect.New(dst.Type().Elem())
+		if err := deepConvert(p.Elem(), src, path); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	case reflect.Slice:
+		if src.Kind() != reflect.Slice && src.Kind() != reflect.Array {
+			break
+		}
+		if src.Kind() == reflect.Slice && src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		return deepConvertElems(dst, src, path)
+	case reflect.Array:
+		if src.Kind() != reflect.Slice && src.Kind() != reflect.Array {
+			break
+		}
+		if src.Len() != dst.Len() {
+			return &ConvertError{Path: path, Src: src.Type(), Dst: dst.Type(), Reason: "length mismatch"}
+		}
+		return deepConvertElems(dst, src, path)
+	case reflect.Map:
+		if src.Kind() != reflect.Map {
+			break
+		}
+		if src.IsNil() {
+			dst.SetZero()
+			return nil
+		}
+		m := reflect.MakeMapWithSize(dst.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			elemPath := fmt.Sprintf("%s[%v]", path, iter.Key())
+			key := reflect.New(dst.Type().Key()).Elem()
+			if err := deepConvert(key, iter.Key(), elemPath); err != nil {
+				return err
+			}
+			val := reflect.New(dst.Type().Elem()).Elem()
+			if err := deepConvert(val, iter.Value(), elemPath); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, val)
+		}
+		dst.Set(m)
+		return nil
+	case reflect.Struct:
+		if src.Kind() != reflect.Struct {
+			break
+		}
+		return deepConvertStruct(dst, src, path)
+	default:
+		if src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()) {
+			dst.Set(src.Convert(dst.Type()))
+			return nil
+		}
+	}
+	return &ConvertError{Path: path, Src: src.Type(), Dst: dst.Type(), Reason: "incompatible types"}
+}
+
+func deepConvertElems(dst, src reflect.Value, path string) error {
+	for i := 0; i < src.Len(); i++ {
+		if err := deepConvert(dst.Index(i), src.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertError reports a value that could not be converted to the requested type.
+type ConvertError struct {
+	Path   string
+	Src    reflect.Type
+	Dst    reflect.Type
+	Reason string
+}
+
+func (e *ConvertError) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "value"
+	}
+	return fmt.Sprintf("ptr: cannot convert %s from %s to %s: %s", path, e.Src, e.Dst, e.Reason)
+}
@@ -0,0 +1,174 @@
+package ptr
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToNested(t *testing.T) {
+	t.Run("[][]string", func(t *testing.T) {
+		value := [][]string{{"foo", "bar"}, {"baz"}}
+
+		pointer := ToSliceSlice(value)
+		assert.Equal(t, [][]*string{{To("foo"), To("bar")}, {To("baz")}}, pointer)
+	})
+
+	t.Run("[]map[string]int64", func(t *testing.T) {
+		value := []map[string]int64{{"foo": 42}, {"bar": 69}}
+
+		pointer := ToSliceMap(value)
+		assert.Equal(t, []map[string]*int64{{"foo": To[int64](42)}, {"bar": To[int64](69)}}, pointer)
+	})
+
+	t.Run("map[string][]string", func(t *testing.T) {
+		value := map[string][]string{"foo": {"bar", "baz"}}
+
+		pointer := ToMapSlice(value)
+		assert.Equal(t, map[string][]*string{"foo": {To("bar"), To("baz")}}, pointer)
+	})
+}
+
+func Test_ValueNested(t *testing.T) {
+	t.Run("[][]*string", func(t *testing.T) {
+		pointer := [][]*string{{To("foo"), nil}, {To("baz")}}
+
+		value := ValueSliceSlice(pointer)
+		assert.Equal(t, [][]string{{"foo", ""}, {"baz"}}, value)
+	})
+
+	t.Run("[]map[string]*int64", func(t *testing.T) {
+		pointer := []map[string]*int64{{"foo": To[int64](42)}, {"bar": To[int64](69)}}
+
+		value := ValueSliceMap(pointer)
+		assert.Equal(t, []map[string]int64{{"foo": 42}, {"bar": 69}}, value)
+	})
+
+	t.Run("map[string][]*string", func(t *testing.T) {
+		pointer := map[string][]*string{"foo": {To("bar"), To("baz")}}
+
+		value := ValueMapSlice(pointer)
+		assert.Equal(t, map[string][]string{"foo": {"bar", "baz"}}, value)
+	})
+}
+
+func Test_ValueDeep(t *testing.T) {
+	t.Run("map[string][]*string", func(t *testing.T) {
+		pointer := map[string][]*string{"foo": {To("bar"), nil}}
+
+		value, err := ValueDeep[map[string][]string](pointer)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{"foo": {"bar", ""}}, value)
+	})
+
+	t.Run("[]map[string]*int64", func(t *testing.T) {
+		pointer := []map[string]*int64{{"foo": To[int64](42)}, nil}
+
+		value, err := ValueDeep[[]map[string]int64](pointer)
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]int64{{"foo": 42}, nil}, value)
+	})
+
+	t.Run("**string", func(t *testing.T) {
+		pointer := To(To("foo"))
+
+		value, err := ValueDeep[string](pointer)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", value)
+
+		value, err = ValueDeep[string]((**string)(nil))
+		require.NoError(t, err)
+		assert.Equal(t, "", value)
+	})
+
+	t.Run("*T", func(t *testing.T) {
+		value, err := ValueDeep[teststruct](&teststruct{"foo", 42})
+		require.NoError(t, err)
+		assert.Equal(t, teststruct{"foo", 42}, value)
+	})
+
+	t.Run("[2]*int/[]any", func(t *testing.T) {
+		pointer := [2]*int{To(42), To(69)}
+
+		value, err := ValueDeep[[]any](pointer)
+		require.NoError(t, err)
+		assert.Equal(t, []any{To(42), To(69)}, value)
+
+		ints, err := ValueDeep[[]int]([]any{To(42), 69})
+		require.NoError(t, err)
+		assert.Equal(t, []int{42, 69}, ints)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := ValueDeep[map[string][]int](map[string][]*string{"foo": {To("bar")}})
+		var convErr *ConvertError
+		require.ErrorAs(t, err, &convErr)
+		assert.Equal(t, "[foo][0]", convErr.Path)
+		assert.EqualError(t, err, "ptr: cannot convert [foo][0] from string to int: incompatible types")
+	})
+
+	t.Run("error/array length", func(t *testing.T) {
+		_, err := ValueDeep[[3]int]([]*int{To(42)})
+		assert.EqualError(t, err, "ptr: cannot convert value from []*int to [3]int: length mismatch")
+	})
+}
+
+func Test_ToDeep(t *testing.T) {
+	t.Run("map[string][]string", func(t *testing.T) {
+		value := map[string][]string{"foo": {"bar", "baz"}}
+
+		pointer, err := ToDeep[map[string][]*string](value)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]*string{"foo": {To("bar"), To("baz")}}, pointer)
+	})
+
+	t.Run("[][]int/[][]**int", func(t *testing.T) {
+		value := [][]int{{42}, nil}
+
+		pointer, err := ToDeep[[][]**int](value)
+		require.NoError(t, err)
+		require.Len(t, pointer, 2)
+		assert.Equal(t, 42, **pointer[0][0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		value := map[string]*string{"foo": nil}
+
+		pointer, err := ToDeep[map[string]**string](value)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]**string{"foo": nil}, pointer)
+	})
+}
+
+func benchmarkMapSlice() map[string][]*string {
+	p := make(map[string][]*string, 100)
+	for i := 0; i < 100; i++ {
+		s := make([]*string, 10)
+		for j := range s {
+			s[j] = To(strconv.Itoa(j))
+		}
+		p[strconv.Itoa(i)] = s
+	}
+	return p
+}
+
+func Benchmark_ValueMapSlice(b *testing.B) {
+	p := benchmarkMapSlice()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ValueMapSlice(p)
+	}
+}
+
+func Benchmark_ValueDeep(b *testing.B) {
+	p := benchmarkMapSlice()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ValueDeep[map[string][]string](p)
+	}
+}
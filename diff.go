@@ -0,0 +1,290 @@
+package ptr
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ChangeKind tells how a field changed between two structs.
+type ChangeKind uint8
+
+const (
+	// ChangeSet is a nil field that got a value.
+	ChangeSet ChangeKind = iota + 1
+	// ChangeCleared is a field with a value that became nil.
+	ChangeCleared
+	// ChangeModified is a field whose value changed.
+	ChangeModified
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeSet:
+		return "set"
+	case ChangeCleared:
+		return "cleared"
+	case ChangeModified:
+		return "modified"
+	}
+	return "unknown"
+}
+
+// FieldChange is a field that differs between two structs.
+// Old and New hold the dereferenced values, nil pointers becoming zero values as with Value,
+// and slices or maps of pointers becoming slices or maps of values.
+type FieldChange struct {
+	Path string
+	Old  any
+	New  any
+	Kind ChangeKind
+}
+
+type diffOptions struct {
+	ignoreTags map[string]string
+	visited    map[diffVisit]bool
+}
+
+// diffVisit is a pair of struct pointers being compared, to stop on cycles.
+type diffVisit struct {
+	typ      reflect.Type
+	old, new uintptr
+}
+
+// DiffOption configures Diff.
+type DiffOption func(*diffOptions)
+
+// IgnoreTagged ignores the fields whose struct tag key has the given value,
+// or any value when value is empty.
+//
+//	ptr.Diff(old, new, ptr.IgnoreTagged("audit", "-"))
+func IgnoreTagged(key, value string) DiffOption {
+	return func(o *diffOptions) {
+		o.ignoreTags[key] = value
+	}
+}
+
+func (o *diffOptions) ignored(f reflect.StructField) bool {
+	for key, value := range o.ignoreTags {
+		v, ok := f.Tag.Lookup(key)
+		if ok && (value == "" || v == value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff returns the changes between the exported fields of the structs old and new,
+// which may be pointers to structs, recursing into nested structs.
+// A nested struct pointer that is nil on one side only is reported as a single
+// Set or Cleared change, pointers already being compared are not revisited,
+// and nil and empty slices or maps are equal.
+// Fields are matched by name, or by the `ptr` tag name, and `ptr:"-"` fields are skipped,
+// as with StructToValues. Fields only present in one of the structs are ignored.
+//
+// Diff panics if old or new is not a struct or a pointer to a struct.
+func Diff(old, new any, opts ...DiffOption) []FieldChange {
+	o := diffOptions{ignoreTags: map[string]string{}, visited: map[diffVisit]bool{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	ov, nv := reflect.ValueOf(old), reflect.ValueOf(new)
+	if !ov.IsValid() || !nv.IsValid() || !isStruct(ov.Type()) || !isStruct(nv.Type()) {
+		panic(fmt.Sprintf("ptr: Diff called with non-struct arguments %T and %T", old, new))
+	}
+	o.visit(ov, nv)
+	return o.diffStruct(nil, derefStruct(ov), derefStruct(nv), "")
+}
+
+// visit marks the struct pointers old and new as compared, reporting false
+// when they already were.
+func (o *diffOptions) visit(old, new reflect.Value) bool {
+	if old.Kind() != reflect.Pointer || new.Kind() != reflect.Pointer {
+		return true
+	}
+	key := diffVisit{old.Type(), old.Pointer(), new.Pointer()}
+	if o.visited[key] {
+		return false
+	}
+	o.visited[key] = true
+	return true
+}
+
+func isStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+// derefStruct follows a pointer to a struct, a nil pointer becoming the zero struct.
+func derefStruct(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Pointer {
+		return v
+	}
+	if v.IsNil() {
+		return reflect.Zero(v.Type().Elem())
+	}
+	return v.Elem()
+}
+
+func (o *diffOptions) diffStruct(changes []FieldChange, old, new reflect.Value, path string) []FieldChange {
+	newFields := structFields(new.Type())
+	for i := 0; i < old.NumField(); i++ {
+		of := old.Type().Field(i)
+		if !of.IsExported() || o.ignored(of) {
+			continue
+		}
+		tag := parseFieldTag(of)
+		if tag.skip {
+			continue
+		}
+		nf, ok := newFields[tag.name]
+		if !ok || o.ignored(nf) {
+			continue
+		}
+
+		fieldPath := of.Name
+		if path != "" {
+			fieldPath = path + "." + of.Name
+		}
+		ov, nv := old.Field(i), new.FieldByIndex(nf.Index)
+		if nestedStruct(ov.Type()) && nestedStruct(nv.Type()) && !isNilValue(ov) && !isNilValue(nv) {
+			if o.visit(ov, nv) {
+				changes = o.diffStruct(changes, derefStruct(ov), derefStruct(nv), fieldPath)
+			}
+			continue
+		}
+		if change, ok := diffField(ov, nv); ok {
+			change.Path = fieldPath
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// nestedStruct reports whether t is a struct, or a pointer to one, with exported
+// fields to recurse into. Others, such as time.Time, are compared as a whole.
+func nestedStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+func diffField(old, new reflect.Value) (FieldChange, bool) {
+	oldNil, newNil := isNilValue(old), isNilValue(new)
+	change := FieldChange{Old: derefValue(old), New: derefValue(new)}
+	switch {
+	case oldNil && newNil:
+		return change, false
+	case oldNil:
+		change.Kind = ChangeSet
+	case newNil:
+		change.Kind = ChangeCleared
+	case equalValues(change.Old, change.New), isEmptyCollection(change.Old) && isEmptyCollection(change.New):
+		return change, false
+	default:
+		change.Kind = ChangeModified
+	}
+	return change, true
+}
+
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// derefValue returns the value v holds, following pointers and interfaces.
+// Nil pointers become the zero value of their element type, and slices or maps
+// of pointers become slices or maps of values.
+func derefValue(v reflect.Value) any {
+	v = derefPointer(v)
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Pointer {
+			break
+		}
+		t := reflect.SliceOf(derefType(v.Type().Elem()))
+		if v.IsNil() {
+			return reflect.Zero(t).Interface()
+		}
+		s := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s.Index(i).Set(derefPointer(v.Index(i)))
+		}
+		return s.Interface()
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.Pointer {
+			break
+		}
+		t := reflect.MapOf(v.Type().Key(), derefType(v.Type().Elem()))
+		if v.IsNil() {
+			return reflect.Zero(t).Interface()
+		}
+		m := reflect.MakeMapWithSize(t, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m.SetMapIndex(iter.Key(), derefPointer(iter.Value()))
+		}
+		return m.Interface()
+	}
+	return v.Interface()
+}
+
+// derefPointer follows pointers and interfaces, nil pointers becoming the zero
+// value of their element type.
+func derefPointer(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			if v.Kind() == reflect.Interface {
+				return v
+			}
+			v = reflect.Zero(v.Type().Elem())
+			continue
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// isEmptyCollection reports whether v is a nil or empty slice or map,
+// which Diff treats as equal.
+func isEmptyCollection(v any) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+	return false
+}
+
+// equalValues compares a and b with their Equal method when they have one,
+// like time.Time, and reflect.DeepEqual otherwise.
+func equalValues(a, b any) bool {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if av.IsValid() && bv.IsValid() && av.Type() == bv.Type() {
+		m, ok := av.Type().MethodByName("Equal")
+		if ok && m.Type.NumIn() == 2 && m.Type.In(1) == av.Type() && m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Bool {
+			return m.Func.Call([]reflect.Value{av, bv})[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,153 @@
+package ptr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type diffAddress struct {
+	Street *string
+	Zip    string
+}
+
+type diffUser struct {
+	Name      *string
+	Age       *int64
+	Email     *string
+	Tags      []*string
+	Address   *diffAddress
+	UpdatedAt *time.Time
+	Internal  *string `ptr:"-"`
+	Version   int     `audit:"-"`
+	Scores    map[string]*int
+}
+
+type diffNode struct {
+	Name   *string
+	Parent *diffNode
+}
+
+func Test_Diff(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		user := diffUser{Name: To("foo"), Age: To[int64](42), Tags: ToSlice([]string{"a"})}
+		clone := diffUser{Name: To("foo"), Age: To[int64](42), Tags: ToSlice([]string{"a"})}
+
+		assert.Empty(t, Diff(user, &clone))
+	})
+
+	t.Run("kinds", func(t *testing.T) {
+		old := diffUser{Name: To("foo"), Email: To("foo@bar.baz")}
+		new := diffUser{Name: To("bar"), Age: To[int64](0)}
+
+		assert.Equal(t, []FieldChange{
+			{Path: "Name", Old: "foo", New: "bar", Kind: ChangeModified},
+			{Path: "Age", Old: int64(0), New: int64(0), Kind: ChangeSet},
+			{Path: "Email", Old: "foo@bar.baz", New: "", Kind: ChangeCleared},
+		}, Diff(old, new))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		old := diffUser{Address: &diffAddress{Street: To("foo"), Zip: "42"}}
+		new := diffUser{Address: &diffAddress{Zip: "69"}}
+
+		assert.Equal(t, []FieldChange{
+			{Path: "Address.Street", Old: "foo", New: "", Kind: ChangeCleared},
+			{Path: "Address.Zip", Old: "42", New: "69", Kind: ChangeModified},
+		}, Diff(old, new))
+
+		assert.Equal(t, []FieldChange{
+			{Path: "Address", Old: diffAddress{}, New: diffAddress{Zip: "69"}, Kind: ChangeSet},
+		}, Diff(diffUser{}, new))
+		assert.Equal(t, []FieldChange{
+			{Path: "Address", Old: diffAddress{Street: To("foo"), Zip: "42"}, New: diffAddress{}, Kind: ChangeCleared},
+		}, Diff(old, diffUser{}))
+		assert.Empty(t, Diff(diffUser{}, diffUser{}))
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		old := &diffNode{Name: To("foo")}
+		old.Parent = old
+		new := &diffNode{Name: To("bar")}
+		new.Parent = new
+
+		assert.Equal(t, []FieldChange{
+			{Path: "Name", Old: "foo", New: "bar", Kind: ChangeModified},
+		}, Diff(old, new))
+
+		new.Parent = &diffNode{Name: To("baz"), Parent: new}
+		assert.Equal(t, []FieldChange{
+			{Path: "Name", Old: "foo", New: "bar", Kind: ChangeModified},
+			{Path: "Parent.Name", Old: "foo", New: "baz", Kind: ChangeModified},
+		}, Diff(old, new))
+	})
+
+	t.Run("slices compare pointees", func(t *testing.T) {
+		old := diffUser{Tags: ToSlice([]string{"a", "b"})}
+		new := diffUser{Tags: ToSlice([]string{"a", "c"})}
+
+		assert.Equal(t, []FieldChange{
+			{Path: "Tags", Old: []string{"a", "b"}, New: []string{"a", "c"}, Kind: ChangeModified},
+		}, Diff(old, new))
+	})
+
+	t.Run("maps compare pointees", func(t *testing.T) {
+		old := diffUser{Scores: map[string]*int{"a": To(1), "b": nil}}
+		new := diffUser{Scores: map[string]*int{"a": To(2), "b": nil}}
+
+		assert.Equal(t, []FieldChange{
+			{Path: "Scores", Old: map[string]int{"a": 1, "b": 0}, New: map[string]int{"a": 2, "b": 0}, Kind: ChangeModified},
+		}, Diff(old, new))
+	})
+
+	t.Run("time uses Equal", func(t *testing.T) {
+		now := time.Now()
+		old := diffUser{UpdatedAt: To(now)}
+		new := diffUser{UpdatedAt: To(now.UTC())}
+
+		assert.Empty(t, Diff(old, new))
+	})
+
+	t.Run("ignored fields", func(t *testing.T) {
+		old := diffUser{Internal: To("foo"), Version: 1}
+		new := diffUser{Internal: To("bar"), Version: 2}
+
+		assert.Equal(t, []FieldChange{
+			{Path: "Version", Old: 1, New: 2, Kind: ChangeModified},
+		}, Diff(old, new))
+		assert.Empty(t, Diff(old, new, IgnoreTagged("audit", "-")))
+		assert.Empty(t, Diff(old, new, IgnoreTagged("audit", "")))
+	})
+
+	t.Run("different struct types", func(t *testing.T) {
+		old := domainUser{Name: "foo", Mail: "foo@bar.baz"}
+		new := sdkUser{Name: To("bar"), Email: To("foo@bar.baz")}
+
+		changes := Diff(old, new)
+		assert.Contains(t, changes, FieldChange{Path: "Name", Old: "foo", New: "bar", Kind: ChangeModified})
+		for _, c := range changes {
+			assert.NotEqual(t, "Mail", c.Path, "Mail matches the Email tag and is unchanged")
+		}
+	})
+
+	t.Run("nil and empty collections", func(t *testing.T) {
+		old := diffUser{Scores: map[string]*int{}}
+		new := diffUser{Tags: []*string{}}
+
+		assert.Empty(t, Diff(old, new))
+		assert.Empty(t, Diff(new, old))
+	})
+
+	t.Run("non struct", func(t *testing.T) {
+		assert.PanicsWithValue(t, "ptr: Diff called with non-struct arguments int and ptr.diffUser", func() { Diff(42, diffUser{}) })
+		assert.PanicsWithValue(t, "ptr: Diff called with non-struct arguments <nil> and ptr.diffUser", func() { Diff(nil, diffUser{}) })
+	})
+}
+
+func Test_ChangeKind_String(t *testing.T) {
+	assert.Equal(t, "set", ChangeSet.String())
+	assert.Equal(t, "cleared", ChangeCleared.String())
+	assert.Equal(t, "modified", ChangeModified.String())
+	assert.Equal(t, "unknown", ChangeKind(0).String())
+}
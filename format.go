@@ -0,0 +1,240 @@
+package ptr
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+)
+
+var (
+	formatterType = reflect.TypeFor[fmt.Formatter]()
+	stringerType  = reflect.TypeFor[fmt.Stringer]()
+	goStringType  = reflect.TypeFor[fmt.GoStringer]()
+	errorType     = reflect.TypeFor[error]()
+)
+
+// FormatOption configures Sprint and Formatter.
+type FormatOption func(*formatter)
+
+// NilToken sets what nil pointers, interfaces and values print as, "<nil>" by default.
+func NilToken(token string) FormatOption {
+	return func(f *formatter) {
+		f.nilToken = token
+	}
+}
+
+// Sprint formats v like fmt.Sprint, but prints the values pointers point to
+// instead of their addresses, see Formatter.
+//
+//	ptr.Sprint(user) // {foo 42 <nil> [a b]}
+func Sprint(v any, opts ...FormatOption) string {
+	return fmt.Sprint(Formatter(v, opts...))
+}
+
+// Formatter wraps v into a fmt.Formatter that dereferences pointers at any depth,
+// including the fields of structs and the elements of slices, arrays and maps,
+// so []*T and map[K]*T print like []T and map[K]T. Nil prints as the NilToken,
+// and a Nullable prints its value, or the NilToken when null or unset.
+//
+// %v, %+v and %#v keep their meaning, and values implementing fmt.Formatter,
+// fmt.Stringer, error or, for %#v, fmt.GoStringer are printed by fmt.
+//
+//	log.Printf("request: %+v", ptr.Formatter(in))
+func Formatter(v any, opts ...FormatOption) fmt.Formatter {
+	f := &formatter{v: v, nilToken: "<nil>"}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+type formatter struct {
+	v        any
+	nilToken string
+}
+
+func (f *formatter) Format(s fmt.State, verb rune) {
+	p := printer{
+		w:        s,
+		format:   fmt.FormatString(s, verb),
+		plus:     s.Flag('+'),
+		sharp:    s.Flag('#') && verb == 'v',
+		nilToken: f.nilToken,
+		visiting: map[visitKey]bool{},
+	}
+	p.print(reflect.ValueOf(f.v))
+}
+
+type visitKey struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+type printer struct {
+	w        io.Writer
+	format   string
+	plus     bool
+	sharp    bool
+	nilToken string
+	// visiting holds the pointers being printed, to leave cycles to fmt.
+	visiting map[visitKey]bool
+}
+
+func (p *printer) print(v reflect.Value) {
+	if !v.IsValid() || (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+		io.WriteString(p.w, p.nilToken)
+		return
+	}
+	if n, ok := asNullable(v); ok {
+		if !n.IsSet() || n.IsNull() {
+			io.WriteString(p.w, p.nilToken)
+			return
+		}
+		p.print(reflect.ValueOf(n.heldValue()))
+		return
+	}
+	if p.hasMethods(v) {
+		p.leaf(v)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		key := visitKey{v.Type(), v.Pointer()}
+		if p.visiting[key] {
+			p.leaf(v)
+			return
+		}
+		p.visiting[key] = true
+		if p.sharp && v.Elem().Kind() == reflect.Struct {
+			io.WriteString(p.w, "&")
+		}
+		p.print(v.Elem())
+		delete(p.visiting, key)
+	case reflect.Interface:
+		p.print(v.Elem())
+	case reflect.Struct:
+		p.open(v.Type(), "{")
+		for i := 0; i < v.NumField(); i++ {
+			p.separate(i)
+			if p.plus || p.sharp {
+				io.WriteString(p.w, v.Type().Field(i).Name+":")
+			}
+			p.print(v.Field(i))
+		}
+		io.WriteString(p.w, "}")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			p.leaf(v)
+			return
+		}
+		p.open(v.Type(), "[")
+		for i := 0; i < v.Len(); i++ {
+			p.separate(i)
+			p.print(v.Index(i))
+		}
+		p.close("]")
+	case reflect.Map:
+		if v.IsNil() {
+			p.leaf(v)
+			return
+		}
+		if !p.sharp {
+			io.WriteString(p.w, "map")
+		}
+		p.open(v.Type(), "[")
+		keys := v.MapKeys()
+		slices.SortFunc(keys, compareKeys)
+		for i, k := range keys {
+			p.separate(i)
+			p.print(k)
+			io.WriteString(p.w, ":")
+			p.print(v.MapIndex(k))
+		}
+		p.close("]")
+	default:
+		p.leaf(v)
+	}
+}
+
+// hasMethods reports whether fmt prints v through one of its methods.
+// Pointers are dereferenced unless the methods have pointer receivers.
+func (p *printer) hasMethods(v reflect.Value) bool {
+	if !v.CanInterface() {
+		return false
+	}
+	if v.Kind() == reflect.Pointer && p.implements(v.Type().Elem()) {
+		return false
+	}
+	return p.implements(v.Type())
+}
+
+func (p *printer) implements(t reflect.Type) bool {
+	if t.Implements(formatterType) {
+		return true
+	}
+	if p.sharp {
+		return t.Implements(goStringType)
+	}
+	return t.Implements(stringerType) || t.Implements(errorType)
+}
+
+// leaf prints v with fmt, which receiving a reflect.Value prints the value it holds.
+func (p *printer) leaf(v reflect.Value) {
+	fmt.Fprintf(p.w, p.format, v)
+}
+
+// open starts a composite, prefixed with its type for %#v,
+// which uses braces instead of brackets.
+func (p *printer) open(t reflect.Type, bracket string) {
+	if p.sharp {
+		io.WriteString(p.w, t.String()+"{")
+		return
+	}
+	io.WriteString(p.w, bracket)
+}
+
+func (p *printer) close(bracket string) {
+	if p.sharp {
+		bracket = "}"
+	}
+	io.WriteString(p.w, bracket)
+}
+
+func (p *printer) separate(i int) {
+	if i == 0 {
+		return
+	}
+	if p.sharp {
+		io.WriteString(p.w, ", ")
+		return
+	}
+	io.WriteString(p.w, " ")
+}
+
+// compareKeys orders map keys like fmt does for the basic kinds,
+// and by their default format otherwise.
+func compareKeys(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	case reflect.Bool:
+		return cmp.Compare(boolInt(a.Bool()), boolInt(b.Bool()))
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package ptr
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type formatAddress struct {
+	Street *string
+}
+
+type formatUser struct {
+	Name    *string
+	Age     *int
+	Address *formatAddress
+	Tags    []*string
+	At      *time.Time
+}
+
+type formatNode struct {
+	Name string
+	Next *formatNode
+}
+
+func Test_Sprint(t *testing.T) {
+	user := formatUser{
+		Name:    To("foo"),
+		Address: &formatAddress{Street: To("bar")},
+		Tags:    ToSlice([]string{"a", "b"}),
+	}
+
+	t.Run("struct", func(t *testing.T) {
+		assert.Equal(t, "{foo <nil> {bar} [a b] <nil>}", Sprint(user))
+		assert.Equal(t, "{foo <nil> {bar} [a b] <nil>}", Sprint(&user))
+		assert.Equal(t, "{foo null {bar} [a b] null}", Sprint(user, NilToken("null")))
+	})
+
+	t.Run("collections", func(t *testing.T) {
+		assert.Equal(t, "[42 <nil> 69]", Sprint([]*int{To(42), nil, To(69)}))
+		assert.Equal(t, "map[bar:<nil> foo:42]", Sprint(map[string]*int{"foo": To(42), "bar": nil}))
+		assert.Equal(t, "map[1:a 2:b]", Sprint(ToMap(map[int]string{2: "b", 1: "a"})))
+		assert.Equal(t, "[]", Sprint([]*int(nil)))
+	})
+
+	t.Run("methods", func(t *testing.T) {
+		at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, "[2024-05-01 10:00:00 +0000 UTC 1s]", Sprint([]any{&at, To(time.Second)}))
+	})
+
+	t.Run("nil and values", func(t *testing.T) {
+		assert.Equal(t, "<nil>", Sprint(nil))
+		assert.Equal(t, "<nil>", Sprint((*int)(nil)))
+		assert.Equal(t, "42", Sprint(To(To(42))))
+		assert.Equal(t, "[102 111 111]", Sprint([]byte("foo")))
+	})
+
+	t.Run("nullable", func(t *testing.T) {
+		v := struct{ N, Null, Unset Nullable[string] }{N: NullableOf("n"), Null: Null[string]()}
+
+		assert.Equal(t, "{n <nil> <nil>}", Sprint(v))
+		assert.Equal(t, "{N:n Null:<nil> Unset:<nil>}", fmt.Sprintf("%+v", Formatter(v)))
+		assert.Equal(t, `struct { N ptr.Nullable[string]; Null ptr.Nullable[string]; Unset ptr.Nullable[string] }{N:"n", Null:nil, Unset:nil}`,
+			fmt.Sprintf("%#v", Formatter(v, NilToken("nil"))))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		node := &formatNode{Name: "foo"}
+		node.Next = node
+
+		assert.Contains(t, Sprint(node), "{foo &{foo 0x", "fmt prints the cycle")
+	})
+}
+
+func Test_Formatter(t *testing.T) {
+	user := formatUser{Name: To("foo"), Age: To(42), Address: &formatAddress{}}
+
+	assert.Equal(t, "{foo 42 {<nil>} [] <nil>}", fmt.Sprintf("%v", Formatter(user)))
+	assert.Equal(t, "{Name:foo Age:42 Address:{Street:<nil>} Tags:[] At:<nil>}", fmt.Sprintf("%+v", Formatter(user)))
+	assert.Equal(t,
+		`ptr.formatUser{Name:"foo", Age:42, Address:&ptr.formatAddress{Street:nil}, Tags:[]*string(nil), At:nil}`,
+		fmt.Sprintf("%#v", Formatter(user, NilToken("nil"))),
+	)
+	assert.Equal(t, `map[string]*int{"foo":42}`, fmt.Sprintf("%#v", Formatter(map[string]*int{"foo": To(42)})))
+	assert.Equal(t, "[  42]", fmt.Sprintf("%4d", Formatter([]*int{To(42)})))
+}
@@ -0,0 +1,74 @@
+package ptr
+
+import "reflect"
+
+// Indirect follows the pointers and interfaces of v, at any depth, and returns
+// the first value that is neither, or nil if it runs into a nil one or a cycle.
+//
+//	ptr.Indirect(&p) // 42 for p := ptr.To(42), nil for p := (*int)(nil)
+func Indirect(v any) any {
+	rv := IndirectValue(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// IndirectValue follows the pointers and interfaces of v, at any depth, and returns
+// the first value that is neither, or the invalid zero Value if it runs into
+// a nil one or a cycle, like x pointing to itself after var x any; x = &x.
+func IndirectValue(v reflect.Value) reflect.Value {
+	var seen pointerChain
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() || seen.revisits(v) {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// pointerChain holds the pointers followed so far, allocated on first use.
+type pointerChain map[visitKey]bool
+
+// revisits records v when it is a pointer and reports whether it was already followed.
+func (c *pointerChain) revisits(v reflect.Value) bool {
+	if v.Kind() != reflect.Pointer {
+		return false
+	}
+	if *c == nil {
+		*c = pointerChain{}
+	}
+	key := visitKey{v.Type(), v.Pointer()}
+	if (*c)[key] {
+		return true
+	}
+	(*c)[key] = true
+	return false
+}
+
+// DerefAll follows the pointers and interfaces of v until it finds a T and returns it,
+// or false if it runs into a nil one, a cycle or a value that is not a T.
+// T may itself be a pointer type, or an interface the final value implements.
+//
+//	s, ok := ptr.DerefAll[string](any(&p)) // p is a *string
+func DerefAll[T any](v any) (T, bool) {
+	typ := reflect.TypeFor[T]()
+	rv := reflect.ValueOf(v)
+	var seen pointerChain
+	for rv.IsValid() {
+		if rv.Type() == typ {
+			return rv.Interface().(T), true
+		}
+		if rv.Kind() != reflect.Pointer && rv.Kind() != reflect.Interface {
+			t, ok := rv.Interface().(T)
+			return t, ok
+		}
+		if rv.IsNil() || seen.revisits(rv) {
+			break
+		}
+		rv = rv.Elem()
+	}
+	var zero T
+	return zero, false
+}
@@ -0,0 +1,77 @@
+package ptr
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Indirect(t *testing.T) {
+	s := To("foo")
+	var nilString *string
+	var anyInt any = To(42)
+
+	assert.Equal(t, "foo", Indirect(&s))
+	assert.Equal(t, 42, Indirect(&anyInt))
+	assert.Equal(t, 42, Indirect(42))
+	assert.Nil(t, Indirect(&nilString))
+	assert.Nil(t, Indirect(nil))
+
+	assert.Equal(t, "foo", IndirectValue(reflect.ValueOf(&s)).Interface())
+	assert.False(t, IndirectValue(reflect.ValueOf(&nilString)).IsValid())
+	assert.False(t, IndirectValue(reflect.Value{}).IsValid())
+
+	var cycle any
+	cycle = &cycle
+	assert.Nil(t, Indirect(cycle))
+	assert.False(t, IndirectValue(reflect.ValueOf(&cycle)).IsValid())
+}
+
+func Test_DerefAll(t *testing.T) {
+	s := To("foo")
+	var anyInt any = To(42)
+
+	t.Run("value", func(t *testing.T) {
+		v, ok := DerefAll[string](&s)
+		assert.True(t, ok)
+		assert.Equal(t, "foo", v)
+
+		i, ok := DerefAll[int](&anyInt)
+		assert.True(t, ok)
+		assert.Equal(t, 42, i)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		p, ok := DerefAll[*string](&s)
+		assert.True(t, ok)
+		assert.Same(t, s, p)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		st, ok := DerefAll[fmt.Stringer](To(To(time.Second)))
+		assert.True(t, ok)
+		assert.Equal(t, "1s", st.String())
+	})
+
+	t.Run("nil or mismatch", func(t *testing.T) {
+		var nilString *string
+
+		_, ok := DerefAll[string](&nilString)
+		assert.False(t, ok)
+		_, ok = DerefAll[int](&s)
+		assert.False(t, ok)
+		_, ok = DerefAll[int](nil)
+		assert.False(t, ok)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		var cycle any
+		cycle = &cycle
+
+		_, ok := DerefAll[int](cycle)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,60 @@
+package ptr
+
+import "iter"
+
+// Values yields the values the pointers of seq point to, nil pointers as zero values.
+func Values[T any](seq iter.Seq[*T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for p := range seq {
+			if !yield(Value(p)) {
+				return
+			}
+		}
+	}
+}
+
+// Pointers yields a pointer to a copy of every value of seq.
+func Pointers[T any](seq iter.Seq[T]) iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for v := range seq {
+			if !yield(&v) {
+				return
+			}
+		}
+	}
+}
+
+// NonNil yields the pointers of seq that are not nil.
+func NonNil[T any](seq iter.Seq[*T]) iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for p := range seq {
+			if p != nil && !yield(p) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesMap yields the keys of seq along with the values their pointers point to,
+// nil pointers as zero values.
+func ValuesMap[K, V any](seq iter.Seq2[K, *V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, p := range seq {
+			if !yield(k, Value(p)) {
+				return
+			}
+		}
+	}
+}
+
+// All yields the indexes of p along with the values its pointers point to,
+// nil pointers as zero values, like slices.All without the dereferencing.
+func All[T any](p []*T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range p {
+			if !yield(i, Value(v)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package ptr
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Values(t *testing.T) {
+	p := []*int{To(42), nil, To(69)}
+
+	assert.Equal(t, []int{42, 0, 69}, slices.Collect(Values(slices.Values(p))))
+
+	var got []int
+	for v := range Values(slices.Values(p)) {
+		got = append(got, v)
+		break
+	}
+	assert.Equal(t, []int{42}, got)
+}
+
+func Test_Pointers(t *testing.T) {
+	v := []string{"foo", "bar"}
+
+	p := slices.Collect(Pointers(slices.Values(v)))
+	assert.Equal(t, []*string{To("foo"), To("bar")}, p)
+
+	*p[0] = "baz"
+	assert.Equal(t, "foo", v[0])
+}
+
+func Test_NonNil(t *testing.T) {
+	p := []*int{nil, To(42), nil, To(69)}
+
+	assert.Equal(t, []*int{p[1], p[3]}, slices.Collect(NonNil(slices.Values(p))))
+	assert.Equal(t, []int{42, 69}, slices.Collect(Values(NonNil(slices.Values(p)))))
+}
+
+func Test_ValuesMap(t *testing.T) {
+	p := map[string]*int{"foo": To(42), "bar": nil}
+
+	assert.Equal(t, map[string]int{"foo": 42, "bar": 0}, maps.Collect(ValuesMap(maps.All(p))))
+}
+
+func Test_All(t *testing.T) {
+	p := []*string{To("foo"), nil}
+
+	got := map[int]string{}
+	for i, v := range All(p) {
+		got[i] = v
+	}
+	assert.Equal(t, map[int]string{0: "foo", 1: ""}, got)
+
+	for i := range All(p) {
+		assert.Equal(t, 0, i)
+		break
+	}
+}
@@ -0,0 +1,135 @@
+package ptr
+
+import (
+	"errors"
+	"reflect"
+)
+
+var errMergePatch = errors.New("ptr: patch must be a struct or a non-nil pointer to a struct")
+
+type mergeOptions struct {
+	clearOnNull bool
+}
+
+// MergeOption configures Merge.
+type MergeOption func(*mergeOptions)
+
+// ClearOnNull makes a null Nullable field of the patch clear the dst field,
+// setting it to nil or its zero value, instead of leaving it untouched.
+func ClearOnNull() MergeOption {
+	return func(o *mergeOptions) {
+		o.clearOnNull = true
+	}
+}
+
+// Merge applies the fields a PATCH style struct sets onto dst, which must be a
+// non-nil pointer to a struct, and returns the paths of the applied fields.
+//
+// Non-nil pointers, slices, maps and interfaces of patch are copied onto the
+// matching dst fields, whether those are pointers or values, nil ones are skipped.
+// Other value fields are applied when non-zero, and a Nullable when it holds a value.
+// Nested structs are merged field by field, allocating a nil dst pointer only
+// when one of its fields is applied.
+//
+// Fields match by name or `ptr` tag as in StructToValues, and `ptr:"-"` skips them.
+// On error, the paths applied so far are returned along with it.
+func Merge(dst, patch any, opts ...MergeOption) ([]string, error) {
+	var o mergeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return nil, errStructDst
+	}
+	p := reflect.ValueOf(patch)
+	if p.Kind() == reflect.Pointer && !p.IsNil() {
+		p = p.Elem()
+	}
+	if p.Kind() != reflect.Struct {
+		return nil, errMergePatch
+	}
+	return o.mergeStruct(nil, d.Elem(), p, "")
+}
+
+func (o *mergeOptions) mergeStruct(applied []string, dst, patch reflect.Value, path string) ([]string, error) {
+	patchFields := structFields(patch.Type())
+	dstType := dst.Type()
+	for i := 0; i < dstType.NumField(); i++ {
+		df := dstType.Field(i)
+		if !df.IsExported() {
+			continue
+		}
+		tag := parseFieldTag(df)
+		if tag.skip {
+			continue
+		}
+		pf, ok := patchFields[tag.name]
+		if !ok {
+			continue
+		}
+
+		fieldPath := df.Name
+		if path != "" {
+			fieldPath = path + "." + df.Name
+		}
+		dv, pv := dst.Field(i), patch.FieldByIndex(pf.Index)
+		if n, ok := pv.Interface().(nullable); ok {
+			if !n.IsSet() || n.IsNull() && !o.clearOnNull {
+				continue
+			}
+			switch {
+			case dv.Type() == pv.Type():
+				dv.Set(cloneValue(pv))
+			case n.IsNull():
+				dv.SetZero()
+			default:
+				if err := deepConvert(dv, cloneValue(reflect.ValueOf(n.heldValue())), fieldPath); err != nil {
+					return applied, err
+				}
+			}
+			applied = append(applied, fieldPath)
+			continue
+		}
+		if isNilValue(pv) || isNilCollection(pv) {
+			continue
+		}
+		if nestedStruct(pv.Type()) && nestedStruct(dv.Type()) {
+			target := dv
+			if dv.Kind() == reflect.Pointer && dv.IsNil() {
+				// merge into a fresh struct, kept only when a field applies
+				target = reflect.New(dv.Type().Elem())
+			}
+			n := len(applied)
+			var err error
+			applied, err = o.mergeStruct(applied, reflect.Indirect(target), reflect.Indirect(pv), fieldPath)
+			if len(applied) > n && target != dv {
+				dv.Set(target)
+			}
+			if err != nil {
+				return applied, err
+			}
+			continue
+		}
+		if pv.Kind() != reflect.Pointer && pv.Kind() != reflect.Interface && pv.IsZero() {
+			continue
+		}
+		// copy the pointee, and the slices and maps it holds, so dst does not alias the patch
+		if pv.Kind() == reflect.Pointer {
+			pv = pv.Elem()
+		}
+		if err := deepConvert(dv, cloneValue(pv), fieldPath); err != nil {
+			return applied, err
+		}
+		applied = append(applied, fieldPath)
+	}
+	return applied, nil
+}
+
+func isNilCollection(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package ptr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mergeAddress struct {
+	Street string
+	Zip    *string
+}
+
+type mergeRecord struct {
+	Name     string
+	Age      int64
+	Email    *string
+	Nick     string
+	Tags     []string
+	Labels   map[string]int
+	Address  mergeAddress
+	Billing  *mergeAddress
+	Timeout  time.Duration
+	Internal string
+}
+
+type mergePatch struct {
+	Name     *string
+	Age      *int64
+	Email    *string
+	Nick     Nullable[string]
+	Tags     []*string
+	Address  *mergeAddress
+	Billing  *mergeAddress
+	Timeout  time.Duration
+	Internal *string `ptr:"-"`
+}
+
+func Test_Merge(t *testing.T) {
+	t.Run("applies non-nil fields", func(t *testing.T) {
+		email := "foo@bar.baz"
+		dst := mergeRecord{Name: "foo", Age: 42, Email: To("old@bar.baz"), Nick: "f", Internal: "keep"}
+		patch := mergePatch{
+			Name:     To("bar"),
+			Email:    &email,
+			Tags:     ToSlice([]string{"a", "b"}),
+			Timeout:  time.Second,
+			Internal: To("overwritten"),
+		}
+
+		applied, err := Merge(&dst, patch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Name", "Email", "Tags", "Timeout"}, applied)
+		assert.Equal(t, mergeRecord{
+			Name:     "bar",
+			Age:      42,
+			Email:    To("foo@bar.baz"),
+			Nick:     "f",
+			Tags:     []string{"a", "b"},
+			Timeout:  time.Second,
+			Internal: "keep",
+		}, dst)
+
+		email = "changed"
+		assert.Equal(t, "foo@bar.baz", *dst.Email, "dst must not alias the patch")
+	})
+
+	t.Run("copies slices and maps", func(t *testing.T) {
+		var dst mergeRecord
+		patch := struct {
+			Tags   []string
+			Labels map[string]int
+		}{[]string{"a"}, map[string]int{"a": 1}}
+
+		_, err := Merge(&dst, &patch)
+		require.NoError(t, err)
+		patch.Tags[0] = "changed"
+		patch.Labels["a"] = 2
+		assert.Equal(t, []string{"a"}, dst.Tags, "dst must not alias the patch")
+		assert.Equal(t, map[string]int{"a": 1}, dst.Labels, "dst must not alias the patch")
+
+		tags := []string{"b"}
+		_, err = Merge(&dst, struct{ Tags *[]string }{&tags})
+		require.NoError(t, err)
+		tags[0] = "changed"
+		assert.Equal(t, []string{"b"}, dst.Tags, "dst must not alias the patch")
+	})
+
+	t.Run("nested structs", func(t *testing.T) {
+		dst := mergeRecord{Address: mergeAddress{Street: "foo", Zip: To("42")}}
+		patch := &mergePatch{
+			Address: &mergeAddress{Zip: To("69")},
+			Billing: &mergeAddress{Street: "bar"},
+		}
+
+		applied, err := Merge(&dst, patch)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Address.Zip", "Billing.Street"}, applied)
+		assert.Equal(t, "foo", dst.Address.Street)
+		assert.Equal(t, "69", *dst.Address.Zip)
+		require.NotNil(t, dst.Billing)
+		assert.Equal(t, "bar", dst.Billing.Street)
+	})
+
+	t.Run("empty nested structs", func(t *testing.T) {
+		var dst mergeRecord
+
+		applied, err := Merge(&dst, struct{ Billing mergeAddress }{})
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+		assert.Nil(t, dst.Billing)
+
+		applied, err = Merge(&dst, mergePatch{Billing: &mergeAddress{}})
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+		assert.Nil(t, dst.Billing)
+	})
+
+	t.Run("nullable", func(t *testing.T) {
+		dst := mergeRecord{Nick: "f"}
+
+		applied, err := Merge(&dst, mergePatch{Nick: Null[string]()})
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+		assert.Equal(t, "f", dst.Nick)
+
+		applied, err = Merge(&dst, mergePatch{Nick: Null[string]()}, ClearOnNull())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Nick"}, applied)
+		assert.Equal(t, "", dst.Nick)
+
+		applied, err = Merge(&dst, mergePatch{Nick: NullableOf("b")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Nick"}, applied)
+		assert.Equal(t, "b", dst.Nick)
+	})
+
+	t.Run("nullable to nullable", func(t *testing.T) {
+		dst := struct{ Nick Nullable[string] }{Nick: NullableOf("f")}
+
+		_, err := Merge(&dst, struct{ Nick Nullable[string] }{Nick: Null[string]()}, ClearOnNull())
+		require.NoError(t, err)
+		assert.True(t, dst.Nick.IsNull())
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		dst := mergeRecord{}
+		applied, err := Merge(&dst, struct {
+			Name *string
+			Age  *string
+		}{Name: To("foo"), Age: To("42")})
+
+		var convErr *ConvertError
+		require.ErrorAs(t, err, &convErr)
+		assert.Equal(t, "Age", convErr.Path)
+		assert.Equal(t, []string{"Name"}, applied)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := Merge(mergeRecord{}, mergePatch{})
+		assert.ErrorIs(t, err, errStructDst)
+
+		_, err = Merge(&mergeRecord{}, 42)
+		assert.ErrorIs(t, err, errMergePatch)
+	})
+}
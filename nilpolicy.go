@@ -0,0 +1,129 @@
+package ptr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNil is returned when a nil pointer is found where a value is required.
+var ErrNil = errors.New("ptr: nil pointer")
+
+type nilMode uint8
+
+const (
+	nilZero nilMode = iota
+	nilSkip
+	nilDefault
+	nilError
+)
+
+type nilOptions[T any] struct {
+	mode        nilMode
+	def         T
+	preserveNil bool
+}
+
+// NilOption configures how ValueSliceWith and ValueMapWith treat nil pointers.
+// When several options set the nil element policy, the last one wins.
+//
+// Options are typed by element, so a DefaultNil of another type does not compile.
+// Options without arguments can't infer it and take it explicitly:
+//
+//	ptr.ValueSliceWith(p, ptr.SkipNil[string](), ptr.PreserveNilContainer[string]())
+//	ptr.ValueSliceWith(p, ptr.DefaultNil("n/a"))
+type NilOption[T any] func(*nilOptions[T])
+
+// ZeroNil converts nil elements to the zero value. This is the default.
+func ZeroNil[T any]() NilOption[T] {
+	return func(o *nilOptions[T]) {
+		o.mode = nilZero
+	}
+}
+
+// SkipNil drops nil elements from the result.
+func SkipNil[T any]() NilOption[T] {
+	return func(o *nilOptions[T]) {
+		o.mode = nilSkip
+	}
+}
+
+// DefaultNil converts nil elements to v.
+func DefaultNil[T any](v T) NilOption[T] {
+	return func(o *nilOptions[T]) {
+		o.mode = nilDefault
+		o.def = v
+	}
+}
+
+// ErrorOnNil fails with ErrNil on the first nil element.
+func ErrorOnNil[T any]() NilOption[T] {
+	return func(o *nilOptions[T]) {
+		o.mode = nilError
+	}
+}
+
+// PreserveNilContainer returns a nil slice or map for a nil input instead of an empty one.
+func PreserveNilContainer[T any]() NilOption[T] {
+	return func(o *nilOptions[T]) {
+		o.preserveNil = true
+	}
+}
+
+func newNilOptions[T any](opts []NilOption[T]) nilOptions[T] {
+	var o nilOptions[T]
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// ValueSliceWith is ValueSlice with a configurable nil policy.
+func ValueSliceWith[T any](p []*T, opts ...NilOption[T]) ([]T, error) {
+	o := newNilOptions(opts)
+	if p == nil && o.preserveNil {
+		return nil, nil
+	}
+	v := make([]T, 0, len(p))
+	for i, val := range p {
+		if val != nil {
+			v = append(v, *val)
+			continue
+		}
+		switch o.mode {
+		case nilZero:
+			var zero T
+			v = append(v, zero)
+		case nilDefault:
+			v = append(v, o.def)
+		case nilError:
+			return nil, fmt.Errorf("%w at index %d", ErrNil, i)
+		}
+	}
+	return v, nil
+}
+
+// ValueMapWith is ValueMap with a configurable nil policy.
+// Unlike ValueMap, nil entries become zero values unless SkipNil is given.
+func ValueMapWith[K comparable, T any](p map[K]*T, opts ...NilOption[T]) (map[K]T, error) {
+	o := newNilOptions(opts)
+	if p == nil && o.preserveNil {
+		return nil, nil
+	}
+	v := make(map[K]T, len(p))
+	for key, val := range p {
+		if val != nil {
+			v[key] = *val
+			continue
+		}
+		switch o.mode {
+		case nilZero:
+			var zero T
+			v[key] = zero
+		case nilDefault:
+			v[key] = o.def
+		case nilError:
+			return nil, fmt.Errorf("%w at key %v", ErrNil, key)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,101 @@
+package ptr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValueSliceWith(t *testing.T) {
+	foo := "foo"
+	pointer := []*string{&foo, nil}
+
+	t.Run("zero", func(t *testing.T) {
+		value, err := ValueSliceWith(pointer)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo", ""}, value)
+
+		value, err = ValueSliceWith(pointer, SkipNil[string](), ZeroNil[string]())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo", ""}, value)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		value, err := ValueSliceWith(pointer, SkipNil[string]())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo"}, value)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		value, err := ValueSliceWith(pointer, DefaultNil("def"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo", "def"}, value)
+	})
+
+	t.Run("default/no nil", func(t *testing.T) {
+		value, err := ValueSliceWith([]*int64{To[int64](1)}, DefaultNil[int64](0))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, value)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := ValueSliceWith(pointer, ErrorOnNil[string]())
+		assert.ErrorIs(t, err, ErrNil)
+		assert.EqualError(t, err, "ptr: nil pointer at index 1")
+	})
+
+	t.Run("nil container", func(t *testing.T) {
+		value, err := ValueSliceWith[string](nil)
+		require.NoError(t, err)
+		assert.NotNil(t, value)
+		assert.Empty(t, value)
+
+		value, err = ValueSliceWith[string](nil, PreserveNilContainer[string]())
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+}
+
+func Test_ValueMapWith(t *testing.T) {
+	foo := "foo"
+	pointer := map[string]*string{
+		"foo": &foo,
+		"bar": nil,
+	}
+
+	t.Run("zero", func(t *testing.T) {
+		value, err := ValueMapWith(pointer)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"foo": "foo", "bar": ""}, value)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		value, err := ValueMapWith(pointer, SkipNil[string]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"foo": "foo"}, value)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		value, err := ValueMapWith(pointer, DefaultNil("def"))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"foo": "foo", "bar": "def"}, value)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := ValueMapWith(pointer, ErrorOnNil[string]())
+		assert.ErrorIs(t, err, ErrNil)
+		assert.EqualError(t, err, "ptr: nil pointer at key bar")
+	})
+
+	t.Run("nil container", func(t *testing.T) {
+		value, err := ValueMapWith[string, string](nil)
+		require.NoError(t, err)
+		assert.NotNil(t, value)
+		assert.Empty(t, value)
+
+		value, err = ValueMapWith[string, string](nil, PreserveNilContainer[string]())
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+}
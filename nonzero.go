@@ -0,0 +1,70 @@
+package ptr
+
+// ToNonZero returns a pointer to v, or nil if v is the zero value,
+// for APIs that reject empty values but accept omitted ones.
+//
+//	in.Name = ptr.ToNonZero(name)
+func ToNonZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// ToNonZeroFunc returns a pointer to v, or nil if isZero reports v as zero.
+// Method expressions fit types with an IsZero method:
+//
+//	in.At = ptr.ToNonZeroFunc(at, time.Time.IsZero)
+func ToNonZeroFunc[T any](v T, isZero func(T) bool) *T {
+	if isZero(v) {
+		return nil
+	}
+	return &v
+}
+
+// ToIf returns a pointer to v if cond holds, or nil.
+func ToIf[T any](cond bool, v T) *T {
+	if !cond {
+		return nil
+	}
+	return &v
+}
+
+// ToSliceNonZero returns pointers to copies of the elements of v, nil for zero values.
+func ToSliceNonZero[T comparable](v []T) []*T {
+	p := make([]*T, len(v))
+	for i := range v {
+		p[i] = ToNonZero(v[i])
+	}
+	return p
+}
+
+// ToSliceNonZeroFunc returns pointers to copies of the elements of v, nil for
+// the ones isZero reports as zero.
+func ToSliceNonZeroFunc[T any](v []T, isZero func(T) bool) []*T {
+	p := make([]*T, len(v))
+	for i := range v {
+		p[i] = ToNonZeroFunc(v[i], isZero)
+	}
+	return p
+}
+
+// ToMapNonZero returns pointers to copies of the values of v, nil for zero values.
+func ToMapNonZero[K, T comparable](v map[K]T) map[K]*T {
+	p := make(map[K]*T, len(v))
+	for k, v := range v {
+		p[k] = ToNonZero(v)
+	}
+	return p
+}
+
+// ToMapNonZeroFunc returns pointers to copies of the values of v, nil for
+// the ones isZero reports as zero.
+func ToMapNonZeroFunc[K comparable, T any](v map[K]T, isZero func(T) bool) map[K]*T {
+	p := make(map[K]*T, len(v))
+	for k, v := range v {
+		p[k] = ToNonZeroFunc(v, isZero)
+	}
+	return p
+}
@@ -0,0 +1,40 @@
+package ptr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ToNonZero(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		assert.Equal(t, To("foo"), ToNonZero("foo"))
+		assert.Nil(t, ToNonZero(""))
+		assert.Nil(t, ToNonZero(teststruct{}))
+		assert.Equal(t, To(teststruct{"foo", 42}), ToNonZero(teststruct{"foo", 42}))
+	})
+
+	t.Run("func", func(t *testing.T) {
+		now := time.Now()
+
+		assert.Equal(t, To(now), ToNonZeroFunc(now, time.Time.IsZero))
+		assert.Nil(t, ToNonZeroFunc(time.Time{}, time.Time.IsZero))
+		assert.Nil(t, ToNonZeroFunc(time.Time{}.In(time.UTC), time.Time.IsZero))
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		assert.Equal(t, []*int{To(42), nil}, ToSliceNonZero([]int{42, 0}))
+		assert.Equal(t, []*time.Time{nil}, ToSliceNonZeroFunc([]time.Time{{}}, time.Time.IsZero))
+	})
+
+	t.Run("map", func(t *testing.T) {
+		assert.Equal(t, map[string]*int{"foo": To(42), "bar": nil}, ToMapNonZero(map[string]int{"foo": 42, "bar": 0}))
+		assert.Equal(t, map[string]*time.Time{"foo": nil}, ToMapNonZeroFunc(map[string]time.Time{"foo": {}}, time.Time.IsZero))
+	})
+}
+
+func Test_ToIf(t *testing.T) {
+	assert.Equal(t, To(42), ToIf(true, 42))
+	assert.Nil(t, ToIf(false, 42))
+}
@@ -0,0 +1,206 @@
+package ptr
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"reflect"
+)
+
+type nullableState uint8
+
+const (
+	nullableUnset nullableState = iota
+	nullableNull
+	nullableValue
+)
+
+var jsonNull = []byte("null")
+
+// Nullable holds an optional value that tells apart three states:
+// unset (never assigned, e.g. a field omitted from a JSON body),
+// explicitly null, and a value.
+//
+// The zero value is unset.
+type Nullable[T any] struct {
+	value T
+	state nullableState
+}
+
+// nullable is implemented by every Nullable, for the reflection based helpers.
+type nullable interface {
+	IsSet() bool
+	IsNull() bool
+	heldValue() any
+}
+
+// asNullable returns v as a nullable when it holds a Nullable.
+func asNullable(v reflect.Value) (nullable, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	n, ok := v.Interface().(nullable)
+	return n, ok
+}
+
+// NullableOf returns a Nullable holding v.
+func NullableOf[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, state: nullableValue}
+}
+
+// Null returns an explicitly null Nullable.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{state: nullableNull}
+}
+
+// From returns a Nullable holding the value p points to, or a null one if p is nil.
+func From[T any](p *T) Nullable[T] {
+	if p == nil {
+		return Null[T]()
+	}
+	return NullableOf(*p)
+}
+
+// Ptr returns a pointer to a copy of the held value, or nil when unset or null.
+func (n Nullable[T]) Ptr() *T {
+	if n.state != nullableValue {
+		return nil
+	}
+	return To(n.value)
+}
+
+// Get returns the held value and whether there is one.
+func (n Nullable[T]) Get() (T, bool) {
+	if n.state != nullableValue {
+		var v T
+		return v, false
+	}
+	return n.value, true
+}
+
+func (n Nullable[T]) heldValue() any {
+	return n.value
+}
+
+// IsSet reports whether n was assigned, either a value or null.
+func (n Nullable[T]) IsSet() bool {
+	return n.state != nullableUnset
+}
+
+// IsNull reports whether n is explicitly null.
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullableNull
+}
+
+// HasValue reports whether n holds a value.
+func (n Nullable[T]) HasValue() bool {
+	return n.state == nullableValue
+}
+
+// IsZero reports whether n is unset, so `json:",omitzero"` omits unset fields.
+func (n Nullable[T]) IsZero() bool {
+	return n.state == nullableUnset
+}
+
+// Set assigns v to n.
+func (n *Nullable[T]) Set(v T) {
+	*n = NullableOf(v)
+}
+
+// SetNull marks n as explicitly null.
+func (n *Nullable[T]) SetNull() {
+	*n = Null[T]()
+}
+
+// Unset resets n to the unset state.
+func (n *Nullable[T]) Unset() {
+	*n = Nullable[T]{}
+}
+
+// MarshalJSON encodes the held value, or null when unset or null.
+// A marshaler can't omit its own field, so unset fields need `json:",omitzero"`
+// to be left out rather than encoded as null.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state != nullableValue {
+		return jsonNull, nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON sets n to null for a JSON null and to the decoded value otherwise.
+// Fields absent from the input are never decoded and so stay unset.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalText encodes the held value as text, or empty text when unset or null.
+// Values that are neither strings nor encoding.TextMarshaler are encoded as JSON.
+func (n Nullable[T]) MarshalText() ([]byte, error) {
+	if n.state != nullableValue {
+		return []byte{}, nil
+	}
+	switch v := any(n.value).(type) {
+	case string:
+		return []byte(v), nil
+	case encoding.TextMarshaler:
+		return v.MarshalText()
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalText sets n to null for empty text and to the decoded value otherwise.
+// Values that are neither strings nor encoding.TextUnmarshaler are decoded as JSON.
+func (n *Nullable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	switch p := any(&v).(type) {
+	case *string:
+		*p = string(text)
+	case encoding.TextUnmarshaler:
+		if err := p.UnmarshalText(text); err != nil {
+			return err
+		}
+	default:
+		if err := json.Unmarshal(text, p); err != nil {
+			return err
+		}
+	}
+	n.Set(v)
+	return nil
+}
+
+// Scan implements sql.Scanner, setting n to null for a NULL column.
+func (n *Nullable[T]) Scan(src any) error {
+	if src == nil {
+		n.SetNull()
+		return nil
+	}
+	var v sql.Null[T]
+	if err := v.Scan(src); err != nil {
+		return err
+	}
+	n.Set(v.V)
+	return nil
+}
+
+// Value implements driver.Valuer, returning NULL when unset or null.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if n.state != nullableValue {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(n.value)
+}
@@ -0,0 +1,207 @@
+package ptr
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ json.Marshaler           = Nullable[int]{}
+	_ json.Unmarshaler         = (*Nullable[int])(nil)
+	_ encoding.TextMarshaler   = Nullable[int]{}
+	_ encoding.TextUnmarshaler = (*Nullable[int])(nil)
+	_ sql.Scanner              = (*Nullable[int])(nil)
+	_ driver.Valuer            = Nullable[int]{}
+)
+
+type patchBody struct {
+	Name Nullable[string] `json:"name"`
+	Age  Nullable[int]    `json:"age"`
+}
+
+func Test_Nullable(t *testing.T) {
+	t.Run("zero", func(t *testing.T) {
+		var n Nullable[string]
+
+		assert.False(t, n.IsSet())
+		assert.False(t, n.IsNull())
+		assert.False(t, n.HasValue())
+		assert.True(t, n.IsZero())
+		assert.Nil(t, n.Ptr())
+	})
+
+	t.Run("value", func(t *testing.T) {
+		n := NullableOf("foo")
+
+		v, ok := n.Get()
+		assert.True(t, ok)
+		assert.Equal(t, "foo", v)
+		assert.True(t, n.IsSet())
+		assert.False(t, n.IsNull())
+		assert.Equal(t, "foo", *n.Ptr())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		n := Null[string]()
+
+		v, ok := n.Get()
+		assert.False(t, ok)
+		assert.Equal(t, "", v)
+		assert.True(t, n.IsSet())
+		assert.True(t, n.IsNull())
+		assert.Nil(t, n.Ptr())
+	})
+
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, NullableOf(42), From(To(42)))
+		assert.Equal(t, Null[int](), From[int](nil))
+	})
+
+	t.Run("setters", func(t *testing.T) {
+		var n Nullable[int]
+
+		n.Set(42)
+		assert.Equal(t, 42, Value(n.Ptr()))
+		n.SetNull()
+		assert.True(t, n.IsNull())
+		n.Unset()
+		assert.False(t, n.IsSet())
+	})
+}
+
+func Test_NullableJSON(t *testing.T) {
+	t.Run("unmarshal", func(t *testing.T) {
+		var body patchBody
+
+		err := json.Unmarshal([]byte(`{"name": null}`), &body)
+		require.NoError(t, err)
+		assert.True(t, body.Name.IsNull())
+		assert.False(t, body.Age.IsSet())
+
+		err = json.Unmarshal([]byte(`{"name": "foo", "age": 42}`), &body)
+		require.NoError(t, err)
+		assert.Equal(t, NullableOf("foo"), body.Name)
+		assert.Equal(t, NullableOf(42), body.Age)
+	})
+
+	t.Run("unmarshal/invalid", func(t *testing.T) {
+		var body patchBody
+
+		err := json.Unmarshal([]byte(`{"age": "foo"}`), &body)
+		assert.Error(t, err)
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(patchBody{Name: NullableOf("foo"), Age: Null[int]()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "foo", "age": null}`, string(data))
+
+		data, err = json.Marshal(patchBody{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": null, "age": null}`, string(data))
+	})
+
+	t.Run("marshal/omitzero", func(t *testing.T) {
+		type body struct {
+			Name Nullable[string] `json:"name,omitzero"`
+			Age  Nullable[int]    `json:"age,omitzero"`
+		}
+
+		data, err := json.Marshal(body{Age: Null[int]()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"age": null}`, string(data))
+	})
+}
+
+func Test_NullableText(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		var n Nullable[string]
+
+		require.NoError(t, n.UnmarshalText([]byte("foo")))
+		assert.Equal(t, NullableOf("foo"), n)
+
+		text, err := n.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "foo", string(text))
+	})
+
+	t.Run("int", func(t *testing.T) {
+		var n Nullable[int]
+
+		require.NoError(t, n.UnmarshalText([]byte("42")))
+		assert.Equal(t, NullableOf(42), n)
+
+		text, err := n.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "42", string(text))
+	})
+
+	t.Run("time", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		var n Nullable[time.Time]
+
+		require.NoError(t, n.UnmarshalText([]byte(now.Format(time.RFC3339))))
+		assert.Equal(t, NullableOf(now), n)
+
+		text, err := n.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, now.Format(time.RFC3339), string(text))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var n Nullable[int]
+
+		require.NoError(t, n.UnmarshalText([]byte{}))
+		assert.True(t, n.IsNull())
+
+		text, err := n.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, text)
+	})
+}
+
+func Test_NullableSQL(t *testing.T) {
+	t.Run("scan", func(t *testing.T) {
+		var n Nullable[int64]
+
+		require.NoError(t, n.Scan(int64(42)))
+		assert.Equal(t, NullableOf(int64(42)), n)
+
+		require.NoError(t, n.Scan(nil))
+		assert.True(t, n.IsNull())
+	})
+
+	t.Run("scan/convert", func(t *testing.T) {
+		var n Nullable[string]
+
+		require.NoError(t, n.Scan([]byte("foo")))
+		assert.Equal(t, NullableOf("foo"), n)
+	})
+
+	t.Run("scan/invalid", func(t *testing.T) {
+		var n Nullable[int]
+
+		assert.Error(t, n.Scan("foo"))
+	})
+
+	t.Run("value", func(t *testing.T) {
+		v, err := NullableOf(42).Value()
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), v)
+
+		v, err = Null[int]().Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+
+		v, err = Nullable[int]{}.Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+}
@@ -0,0 +1,96 @@
+package ptr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeFor[time.Time]()
+	rawMessageType = reflect.TypeFor[json.RawMessage]()
+
+	errInvalidJSON = errors.New("invalid JSON")
+)
+
+type parseOptions struct {
+	layouts []string
+}
+
+// ParseOption configures Parse.
+type ParseOption func(*parseOptions)
+
+// TimeLayouts sets the layouts time.Time values are parsed with, tried in order.
+// It defaults to time.RFC3339Nano, time.DateTime and time.DateOnly,
+// and without layouts time.Time.UnmarshalText is used.
+func TimeLayouts(layouts ...string) ParseOption {
+	return func(o *parseOptions) {
+		o.layouts = layouts
+	}
+}
+
+// Parse parses s into a new T, or returns nil if s is empty or only whitespace.
+//
+// It supports the types of the generated wrappers: strings, bools and numbers
+// through strconv, time.Time through time.Parse, time.Duration through
+// time.ParseDuration, json.RawMessage holding valid JSON, and any type
+// implementing encoding.TextUnmarshaler. Surrounding whitespace is trimmed,
+// except for strings.
+//
+//	limit, err := ptr.Parse[int64](r.URL.Query().Get("limit"))
+func Parse[T any](s string, opts ...ParseOption) (*T, error) {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return nil, nil
+	}
+	o := parseOptions{layouts: []string{time.RFC3339Nano, time.DateTime, time.DateOnly}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() != reflect.String {
+		s = trimmed
+	}
+	if err := o.parse(rv, s); err != nil {
+		return nil, fmt.Errorf("ptr: cannot parse %q as %s: %w", s, rv.Type(), err)
+	}
+	return &v, nil
+}
+
+// ParsePtr parses *s like Parse, or returns nil if s is nil.
+func ParsePtr[T any](s *string, opts ...ParseOption) (*T, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return Parse[T](*s, opts...)
+}
+
+func (o *parseOptions) parse(v reflect.Value, s string) error {
+	switch v.Type() {
+	case timeType:
+		if len(o.layouts) == 0 {
+			break
+		}
+		var err error
+		for _, layout := range o.layouts {
+			var t time.Time
+			if t, err = time.Parse(layout, s); err == nil {
+				v.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return err
+	case rawMessageType:
+		if !json.Valid([]byte(s)) {
+			return errInvalidJSON
+		}
+		v.SetBytes([]byte(s))
+		return nil
+	}
+	return setText(v, s)
+}
@@ -0,0 +1,97 @@
+package ptr
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		for _, s := range []string{"", " ", "\t\n"} {
+			p, err := Parse[int64](s)
+			require.NoError(t, err)
+			assert.Nil(t, p)
+		}
+	})
+
+	t.Run("strconv", func(t *testing.T) {
+		i, err := Parse[int64](" 42 ")
+		require.NoError(t, err)
+		assert.Equal(t, To[int64](42), i)
+
+		b, err := Parse[bool]("true")
+		require.NoError(t, err)
+		assert.Equal(t, To(true), b)
+
+		f, err := Parse[float32]("4.2")
+		require.NoError(t, err)
+		assert.Equal(t, To[float32](4.2), f)
+
+		s, err := Parse[string](" foo ")
+		require.NoError(t, err)
+		assert.Equal(t, To(" foo "), s)
+	})
+
+	t.Run("time", func(t *testing.T) {
+		at, err := Parse[time.Time]("2024-05-01T10:00:00Z")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), *at)
+
+		at, err = Parse[time.Time]("2024-05-01")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *at)
+
+		at, err = Parse[time.Time]("01/05/2024", TimeLayouts("02/01/2006"))
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *at)
+
+		at, err = Parse[time.Time]("2024-05-01T10:00:00Z", TimeLayouts())
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), *at)
+
+		d, err := Parse[time.Duration]("1m30s")
+		require.NoError(t, err)
+		assert.Equal(t, To(90*time.Second), d)
+	})
+
+	t.Run("text unmarshaler", func(t *testing.T) {
+		addr, err := Parse[netip.Addr]("127.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, To(netip.MustParseAddr("127.0.0.1")), addr)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		raw, err := Parse[json.RawMessage](`{"foo":42}`)
+		require.NoError(t, err)
+		assert.Equal(t, To(json.RawMessage(`{"foo":42}`)), raw)
+
+		_, err = Parse[json.RawMessage](`{"foo"`)
+		assert.ErrorIs(t, err, errInvalidJSON)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := Parse[int8]("420")
+		assert.EqualError(t, err, `ptr: cannot parse "420" as int8: strconv.ParseInt: parsing "420": value out of range`)
+
+		_, err = Parse[time.Time]("yesterday")
+		assert.Error(t, err)
+
+		_, err = Parse[[]int]("42")
+		assert.EqualError(t, err, `ptr: cannot parse "42" as []int: unsupported type []int`)
+	})
+}
+
+func Test_ParsePtr(t *testing.T) {
+	p, err := ParsePtr[int](nil)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	p, err = ParsePtr[int](To("42"))
+	require.NoError(t, err)
+	assert.Equal(t, To(42), p)
+}
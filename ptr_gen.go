@@ -0,0 +1,2153 @@
+// Code generated by ptrgen; DO NOT EDIT.
+
+package ptr
+
+import (
+	"encoding/json"
+	"net/netip"
+	"time"
+)
+
+func String(v string) *string {
+	return To(v)
+}
+
+func StringSlice(v []string) []*string {
+	return ToSlice(v)
+}
+
+func StringMap(v map[string]string) map[string]*string {
+	return ToMap(v)
+}
+
+func StringMapOf[K comparable](v map[K]string) map[K]*string {
+	return ToMap(v)
+}
+
+func StringValue(v *string) string {
+	return Value(v)
+}
+
+func StringValueSlice(v []*string) []string {
+	return ValueSlice(v)
+}
+
+func StringValueMap(v map[string]*string) map[string]string {
+	return ValueMap(v)
+}
+
+func StringValueMapOf[K comparable](v map[K]*string) map[K]string {
+	return ValueMap(v)
+}
+
+func StringValueOr(v *string, def string) string {
+	return ValueOr(v, def)
+}
+
+func StringValueSliceOr(v []*string, def string) []string {
+	return ValueSliceOr(v, def)
+}
+
+func StringValueMapOr(v map[string]*string, def string) map[string]string {
+	return ValueMapOr(v, def)
+}
+
+func StringValueMapOrOf[K comparable](v map[K]*string, def string) map[K]string {
+	return ValueMapOr(v, def)
+}
+
+func StringValueSliceWith(v []*string, opts ...NilOption[string]) ([]string, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func StringValueMapWith(v map[string]*string, opts ...NilOption[string]) (map[string]string, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func StringValueMapWithOf[K comparable](v map[K]*string, opts ...NilOption[string]) (map[K]string, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToStringNonEmpty(v string) *string {
+	return ToNonZero(v)
+}
+
+func ToStringSliceNonEmpty(v []string) []*string {
+	return ToSliceNonZero(v)
+}
+
+func ToStringMapNonEmpty(v map[string]string) map[string]*string {
+	return ToMapNonZero(v)
+}
+
+func ToStringMapNonEmptyOf[K comparable](v map[K]string) map[K]*string {
+	return ToMapNonZero(v)
+}
+
+func ParseString(s string, opts ...ParseOption) (*string, error) {
+	return Parse[string](s, opts...)
+}
+
+func Byte(v byte) *byte {
+	return To(v)
+}
+
+func ByteSlice(v []byte) []*byte {
+	return ToSlice(v)
+}
+
+func ByteMap(v map[string]byte) map[string]*byte {
+	return ToMap(v)
+}
+
+func ByteMapOf[K comparable](v map[K]byte) map[K]*byte {
+	return ToMap(v)
+}
+
+func ByteValue(v *byte) byte {
+	return Value(v)
+}
+
+func ByteValueSlice(v []*byte) []byte {
+	return ValueSlice(v)
+}
+
+func ByteValueMap(v map[string]*byte) map[string]byte {
+	return ValueMap(v)
+}
+
+func ByteValueMapOf[K comparable](v map[K]*byte) map[K]byte {
+	return ValueMap(v)
+}
+
+func ByteValueOr(v *byte, def byte) byte {
+	return ValueOr(v, def)
+}
+
+func ByteValueSliceOr(v []*byte, def byte) []byte {
+	return ValueSliceOr(v, def)
+}
+
+func ByteValueMapOr(v map[string]*byte, def byte) map[string]byte {
+	return ValueMapOr(v, def)
+}
+
+func ByteValueMapOrOf[K comparable](v map[K]*byte, def byte) map[K]byte {
+	return ValueMapOr(v, def)
+}
+
+func ByteValueSliceWith(v []*byte, opts ...NilOption[byte]) ([]byte, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func ByteValueMapWith(v map[string]*byte, opts ...NilOption[byte]) (map[string]byte, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ByteValueMapWithOf[K comparable](v map[K]*byte, opts ...NilOption[byte]) (map[K]byte, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToByteNonZero(v byte) *byte {
+	return ToNonZero(v)
+}
+
+func ToByteSliceNonZero(v []byte) []*byte {
+	return ToSliceNonZero(v)
+}
+
+func ToByteMapNonZero(v map[string]byte) map[string]*byte {
+	return ToMapNonZero(v)
+}
+
+func ToByteMapNonZeroOf[K comparable](v map[K]byte) map[K]*byte {
+	return ToMapNonZero(v)
+}
+
+func ParseByte(s string, opts ...ParseOption) (*byte, error) {
+	return Parse[byte](s, opts...)
+}
+
+func Rune(v rune) *rune {
+	return To(v)
+}
+
+func RuneSlice(v []rune) []*rune {
+	return ToSlice(v)
+}
+
+func RuneMap(v map[string]rune) map[string]*rune {
+	return ToMap(v)
+}
+
+func RuneMapOf[K comparable](v map[K]rune) map[K]*rune {
+	return ToMap(v)
+}
+
+func RuneValue(v *rune) rune {
+	return Value(v)
+}
+
+func RuneValueSlice(v []*rune) []rune {
+	return ValueSlice(v)
+}
+
+func RuneValueMap(v map[string]*rune) map[string]rune {
+	return ValueMap(v)
+}
+
+func RuneValueMapOf[K comparable](v map[K]*rune) map[K]rune {
+	return ValueMap(v)
+}
+
+func RuneValueOr(v *rune, def rune) rune {
+	return ValueOr(v, def)
+}
+
+func RuneValueSliceOr(v []*rune, def rune) []rune {
+	return ValueSliceOr(v, def)
+}
+
+func RuneValueMapOr(v map[string]*rune, def rune) map[string]rune {
+	return ValueMapOr(v, def)
+}
+
+func RuneValueMapOrOf[K comparable](v map[K]*rune, def rune) map[K]rune {
+	return ValueMapOr(v, def)
+}
+
+func RuneValueSliceWith(v []*rune, opts ...NilOption[rune]) ([]rune, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func RuneValueMapWith(v map[string]*rune, opts ...NilOption[rune]) (map[string]rune, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func RuneValueMapWithOf[K comparable](v map[K]*rune, opts ...NilOption[rune]) (map[K]rune, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToRuneNonZero(v rune) *rune {
+	return ToNonZero(v)
+}
+
+func ToRuneSliceNonZero(v []rune) []*rune {
+	return ToSliceNonZero(v)
+}
+
+func ToRuneMapNonZero(v map[string]rune) map[string]*rune {
+	return ToMapNonZero(v)
+}
+
+func ToRuneMapNonZeroOf[K comparable](v map[K]rune) map[K]*rune {
+	return ToMapNonZero(v)
+}
+
+func ParseRune(s string, opts ...ParseOption) (*rune, error) {
+	return Parse[rune](s, opts...)
+}
+
+func Bool(v bool) *bool {
+	return To(v)
+}
+
+func BoolSlice(v []bool) []*bool {
+	return ToSlice(v)
+}
+
+func BoolMap(v map[string]bool) map[string]*bool {
+	return ToMap(v)
+}
+
+func BoolMapOf[K comparable](v map[K]bool) map[K]*bool {
+	return ToMap(v)
+}
+
+func BoolValue(v *bool) bool {
+	return Value(v)
+}
+
+func BoolValueSlice(v []*bool) []bool {
+	return ValueSlice(v)
+}
+
+func BoolValueMap(v map[string]*bool) map[string]bool {
+	return ValueMap(v)
+}
+
+func BoolValueMapOf[K comparable](v map[K]*bool) map[K]bool {
+	return ValueMap(v)
+}
+
+func BoolValueOr(v *bool, def bool) bool {
+	return ValueOr(v, def)
+}
+
+func BoolValueSliceOr(v []*bool, def bool) []bool {
+	return ValueSliceOr(v, def)
+}
+
+func BoolValueMapOr(v map[string]*bool, def bool) map[string]bool {
+	return ValueMapOr(v, def)
+}
+
+func BoolValueMapOrOf[K comparable](v map[K]*bool, def bool) map[K]bool {
+	return ValueMapOr(v, def)
+}
+
+func BoolValueSliceWith(v []*bool, opts ...NilOption[bool]) ([]bool, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func BoolValueMapWith(v map[string]*bool, opts ...NilOption[bool]) (map[string]bool, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func BoolValueMapWithOf[K comparable](v map[K]*bool, opts ...NilOption[bool]) (map[K]bool, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ParseBool(s string, opts ...ParseOption) (*bool, error) {
+	return Parse[bool](s, opts...)
+}
+
+func Int(v int) *int {
+	return To(v)
+}
+
+func IntSlice(v []int) []*int {
+	return ToSlice(v)
+}
+
+func IntMap(v map[string]int) map[string]*int {
+	return ToMap(v)
+}
+
+func IntMapOf[K comparable](v map[K]int) map[K]*int {
+	return ToMap(v)
+}
+
+func IntValue(v *int) int {
+	return Value(v)
+}
+
+func IntValueSlice(v []*int) []int {
+	return ValueSlice(v)
+}
+
+func IntValueMap(v map[string]*int) map[string]int {
+	return ValueMap(v)
+}
+
+func IntValueMapOf[K comparable](v map[K]*int) map[K]int {
+	return ValueMap(v)
+}
+
+func IntValueOr(v *int, def int) int {
+	return ValueOr(v, def)
+}
+
+func IntValueSliceOr(v []*int, def int) []int {
+	return ValueSliceOr(v, def)
+}
+
+func IntValueMapOr(v map[string]*int, def int) map[string]int {
+	return ValueMapOr(v, def)
+}
+
+func IntValueMapOrOf[K comparable](v map[K]*int, def int) map[K]int {
+	return ValueMapOr(v, def)
+}
+
+func IntValueSliceWith(v []*int, opts ...NilOption[int]) ([]int, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func IntValueMapWith(v map[string]*int, opts ...NilOption[int]) (map[string]int, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func IntValueMapWithOf[K comparable](v map[K]*int, opts ...NilOption[int]) (map[K]int, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToIntNonZero(v int) *int {
+	return ToNonZero(v)
+}
+
+func ToIntSliceNonZero(v []int) []*int {
+	return ToSliceNonZero(v)
+}
+
+func ToIntMapNonZero(v map[string]int) map[string]*int {
+	return ToMapNonZero(v)
+}
+
+func ToIntMapNonZeroOf[K comparable](v map[K]int) map[K]*int {
+	return ToMapNonZero(v)
+}
+
+func ParseInt(s string, opts ...ParseOption) (*int, error) {
+	return Parse[int](s, opts...)
+}
+
+func Int8(v int8) *int8 {
+	return To(v)
+}
+
+func Int8Slice(v []int8) []*int8 {
+	return ToSlice(v)
+}
+
+func Int8Map(v map[string]int8) map[string]*int8 {
+	return ToMap(v)
+}
+
+func Int8MapOf[K comparable](v map[K]int8) map[K]*int8 {
+	return ToMap(v)
+}
+
+func Int8Value(v *int8) int8 {
+	return Value(v)
+}
+
+func Int8ValueSlice(v []*int8) []int8 {
+	return ValueSlice(v)
+}
+
+func Int8ValueMap(v map[string]*int8) map[string]int8 {
+	return ValueMap(v)
+}
+
+func Int8ValueMapOf[K comparable](v map[K]*int8) map[K]int8 {
+	return ValueMap(v)
+}
+
+func Int8ValueOr(v *int8, def int8) int8 {
+	return ValueOr(v, def)
+}
+
+func Int8ValueSliceOr(v []*int8, def int8) []int8 {
+	return ValueSliceOr(v, def)
+}
+
+func Int8ValueMapOr(v map[string]*int8, def int8) map[string]int8 {
+	return ValueMapOr(v, def)
+}
+
+func Int8ValueMapOrOf[K comparable](v map[K]*int8, def int8) map[K]int8 {
+	return ValueMapOr(v, def)
+}
+
+func Int8ValueSliceWith(v []*int8, opts ...NilOption[int8]) ([]int8, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Int8ValueMapWith(v map[string]*int8, opts ...NilOption[int8]) (map[string]int8, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Int8ValueMapWithOf[K comparable](v map[K]*int8, opts ...NilOption[int8]) (map[K]int8, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToInt8NonZero(v int8) *int8 {
+	return ToNonZero(v)
+}
+
+func ToInt8SliceNonZero(v []int8) []*int8 {
+	return ToSliceNonZero(v)
+}
+
+func ToInt8MapNonZero(v map[string]int8) map[string]*int8 {
+	return ToMapNonZero(v)
+}
+
+func ToInt8MapNonZeroOf[K comparable](v map[K]int8) map[K]*int8 {
+	return ToMapNonZero(v)
+}
+
+func ParseInt8(s string, opts ...ParseOption) (*int8, error) {
+	return Parse[int8](s, opts...)
+}
+
+func Int16(v int16) *int16 {
+	return To(v)
+}
+
+func Int16Slice(v []int16) []*int16 {
+	return ToSlice(v)
+}
+
+func Int16Map(v map[string]int16) map[string]*int16 {
+	return ToMap(v)
+}
+
+func Int16MapOf[K comparable](v map[K]int16) map[K]*int16 {
+	return ToMap(v)
+}
+
+func Int16Value(v *int16) int16 {
+	return Value(v)
+}
+
+func Int16ValueSlice(v []*int16) []int16 {
+	return ValueSlice(v)
+}
+
+func Int16ValueMap(v map[string]*int16) map[string]int16 {
+	return ValueMap(v)
+}
+
+func Int16ValueMapOf[K comparable](v map[K]*int16) map[K]int16 {
+	return ValueMap(v)
+}
+
+func Int16ValueOr(v *int16, def int16) int16 {
+	return ValueOr(v, def)
+}
+
+func Int16ValueSliceOr(v []*int16, def int16) []int16 {
+	return ValueSliceOr(v, def)
+}
+
+func Int16ValueMapOr(v map[string]*int16, def int16) map[string]int16 {
+	return ValueMapOr(v, def)
+}
+
+func Int16ValueMapOrOf[K comparable](v map[K]*int16, def int16) map[K]int16 {
+	return ValueMapOr(v, def)
+}
+
+func Int16ValueSliceWith(v []*int16, opts ...NilOption[int16]) ([]int16, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Int16ValueMapWith(v map[string]*int16, opts ...NilOption[int16]) (map[string]int16, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Int16ValueMapWithOf[K comparable](v map[K]*int16, opts ...NilOption[int16]) (map[K]int16, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToInt16NonZero(v int16) *int16 {
+	return ToNonZero(v)
+}
+
+func ToInt16SliceNonZero(v []int16) []*int16 {
+	return ToSliceNonZero(v)
+}
+
+func ToInt16MapNonZero(v map[string]int16) map[string]*int16 {
+	return ToMapNonZero(v)
+}
+
+func ToInt16MapNonZeroOf[K comparable](v map[K]int16) map[K]*int16 {
+	return ToMapNonZero(v)
+}
+
+func ParseInt16(s string, opts ...ParseOption) (*int16, error) {
+	return Parse[int16](s, opts...)
+}
+
+func Int32(v int32) *int32 {
+	return To(v)
+}
+
+func Int32Slice(v []int32) []*int32 {
+	return ToSlice(v)
+}
+
+func Int32Map(v map[string]int32) map[string]*int32 {
+	return ToMap(v)
+}
+
+func Int32MapOf[K comparable](v map[K]int32) map[K]*int32 {
+	return ToMap(v)
+}
+
+func Int32Value(v *int32) int32 {
+	return Value(v)
+}
+
+func Int32ValueSlice(v []*int32) []int32 {
+	return ValueSlice(v)
+}
+
+func Int32ValueMap(v map[string]*int32) map[string]int32 {
+	return ValueMap(v)
+}
+
+func Int32ValueMapOf[K comparable](v map[K]*int32) map[K]int32 {
+	return ValueMap(v)
+}
+
+func Int32ValueOr(v *int32, def int32) int32 {
+	return ValueOr(v, def)
+}
+
+func Int32ValueSliceOr(v []*int32, def int32) []int32 {
+	return ValueSliceOr(v, def)
+}
+
+func Int32ValueMapOr(v map[string]*int32, def int32) map[string]int32 {
+	return ValueMapOr(v, def)
+}
+
+func Int32ValueMapOrOf[K comparable](v map[K]*int32, def int32) map[K]int32 {
+	return ValueMapOr(v, def)
+}
+
+func Int32ValueSliceWith(v []*int32, opts ...NilOption[int32]) ([]int32, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Int32ValueMapWith(v map[string]*int32, opts ...NilOption[int32]) (map[string]int32, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Int32ValueMapWithOf[K comparable](v map[K]*int32, opts ...NilOption[int32]) (map[K]int32, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToInt32NonZero(v int32) *int32 {
+	return ToNonZero(v)
+}
+
+func ToInt32SliceNonZero(v []int32) []*int32 {
+	return ToSliceNonZero(v)
+}
+
+func ToInt32MapNonZero(v map[string]int32) map[string]*int32 {
+	return ToMapNonZero(v)
+}
+
+func ToInt32MapNonZeroOf[K comparable](v map[K]int32) map[K]*int32 {
+	return ToMapNonZero(v)
+}
+
+func ParseInt32(s string, opts ...ParseOption) (*int32, error) {
+	return Parse[int32](s, opts...)
+}
+
+func Int64(v int64) *int64 {
+	return To(v)
+}
+
+func Int64Slice(v []int64) []*int64 {
+	return ToSlice(v)
+}
+
+func Int64Map(v map[string]int64) map[string]*int64 {
+	return ToMap(v)
+}
+
+func Int64MapOf[K comparable](v map[K]int64) map[K]*int64 {
+	return ToMap(v)
+}
+
+func Int64Value(v *int64) int64 {
+	return Value(v)
+}
+
+func Int64ValueSlice(v []*int64) []int64 {
+	return ValueSlice(v)
+}
+
+func Int64ValueMap(v map[string]*int64) map[string]int64 {
+	return ValueMap(v)
+}
+
+func Int64ValueMapOf[K comparable](v map[K]*int64) map[K]int64 {
+	return ValueMap(v)
+}
+
+func Int64ValueOr(v *int64, def int64) int64 {
+	return ValueOr(v, def)
+}
+
+func Int64ValueSliceOr(v []*int64, def int64) []int64 {
+	return ValueSliceOr(v, def)
+}
+
+func Int64ValueMapOr(v map[string]*int64, def int64) map[string]int64 {
+	return ValueMapOr(v, def)
+}
+
+func Int64ValueMapOrOf[K comparable](v map[K]*int64, def int64) map[K]int64 {
+	return ValueMapOr(v, def)
+}
+
+func Int64ValueSliceWith(v []*int64, opts ...NilOption[int64]) ([]int64, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Int64ValueMapWith(v map[string]*int64, opts ...NilOption[int64]) (map[string]int64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Int64ValueMapWithOf[K comparable](v map[K]*int64, opts ...NilOption[int64]) (map[K]int64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToInt64NonZero(v int64) *int64 {
+	return ToNonZero(v)
+}
+
+func ToInt64SliceNonZero(v []int64) []*int64 {
+	return ToSliceNonZero(v)
+}
+
+func ToInt64MapNonZero(v map[string]int64) map[string]*int64 {
+	return ToMapNonZero(v)
+}
+
+func ToInt64MapNonZeroOf[K comparable](v map[K]int64) map[K]*int64 {
+	return ToMapNonZero(v)
+}
+
+func ParseInt64(s string, opts ...ParseOption) (*int64, error) {
+	return Parse[int64](s, opts...)
+}
+
+func Uint(v uint) *uint {
+	return To(v)
+}
+
+func UintSlice(v []uint) []*uint {
+	return ToSlice(v)
+}
+
+func UintMap(v map[string]uint) map[string]*uint {
+	return ToMap(v)
+}
+
+func UintMapOf[K comparable](v map[K]uint) map[K]*uint {
+	return ToMap(v)
+}
+
+func UintValue(v *uint) uint {
+	return Value(v)
+}
+
+func UintValueSlice(v []*uint) []uint {
+	return ValueSlice(v)
+}
+
+func UintValueMap(v map[string]*uint) map[string]uint {
+	return ValueMap(v)
+}
+
+func UintValueMapOf[K comparable](v map[K]*uint) map[K]uint {
+	return ValueMap(v)
+}
+
+func UintValueOr(v *uint, def uint) uint {
+	return ValueOr(v, def)
+}
+
+func UintValueSliceOr(v []*uint, def uint) []uint {
+	return ValueSliceOr(v, def)
+}
+
+func UintValueMapOr(v map[string]*uint, def uint) map[string]uint {
+	return ValueMapOr(v, def)
+}
+
+func UintValueMapOrOf[K comparable](v map[K]*uint, def uint) map[K]uint {
+	return ValueMapOr(v, def)
+}
+
+func UintValueSliceWith(v []*uint, opts ...NilOption[uint]) ([]uint, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func UintValueMapWith(v map[string]*uint, opts ...NilOption[uint]) (map[string]uint, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func UintValueMapWithOf[K comparable](v map[K]*uint, opts ...NilOption[uint]) (map[K]uint, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToUintNonZero(v uint) *uint {
+	return ToNonZero(v)
+}
+
+func ToUintSliceNonZero(v []uint) []*uint {
+	return ToSliceNonZero(v)
+}
+
+func ToUintMapNonZero(v map[string]uint) map[string]*uint {
+	return ToMapNonZero(v)
+}
+
+func ToUintMapNonZeroOf[K comparable](v map[K]uint) map[K]*uint {
+	return ToMapNonZero(v)
+}
+
+func ParseUint(s string, opts ...ParseOption) (*uint, error) {
+	return Parse[uint](s, opts...)
+}
+
+func Uint8(v uint8) *uint8 {
+	return To(v)
+}
+
+func Uint8Slice(v []uint8) []*uint8 {
+	return ToSlice(v)
+}
+
+func Uint8Map(v map[string]uint8) map[string]*uint8 {
+	return ToMap(v)
+}
+
+func Uint8MapOf[K comparable](v map[K]uint8) map[K]*uint8 {
+	return ToMap(v)
+}
+
+func Uint8Value(v *uint8) uint8 {
+	return Value(v)
+}
+
+func Uint8ValueSlice(v []*uint8) []uint8 {
+	return ValueSlice(v)
+}
+
+func Uint8ValueMap(v map[string]*uint8) map[string]uint8 {
+	return ValueMap(v)
+}
+
+func Uint8ValueMapOf[K comparable](v map[K]*uint8) map[K]uint8 {
+	return ValueMap(v)
+}
+
+func Uint8ValueOr(v *uint8, def uint8) uint8 {
+	return ValueOr(v, def)
+}
+
+func Uint8ValueSliceOr(v []*uint8, def uint8) []uint8 {
+	return ValueSliceOr(v, def)
+}
+
+func Uint8ValueMapOr(v map[string]*uint8, def uint8) map[string]uint8 {
+	return ValueMapOr(v, def)
+}
+
+func Uint8ValueMapOrOf[K comparable](v map[K]*uint8, def uint8) map[K]uint8 {
+	return ValueMapOr(v, def)
+}
+
+func Uint8ValueSliceWith(v []*uint8, opts ...NilOption[uint8]) ([]uint8, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Uint8ValueMapWith(v map[string]*uint8, opts ...NilOption[uint8]) (map[string]uint8, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Uint8ValueMapWithOf[K comparable](v map[K]*uint8, opts ...NilOption[uint8]) (map[K]uint8, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToUint8NonZero(v uint8) *uint8 {
+	return ToNonZero(v)
+}
+
+func ToUint8SliceNonZero(v []uint8) []*uint8 {
+	return ToSliceNonZero(v)
+}
+
+func ToUint8MapNonZero(v map[string]uint8) map[string]*uint8 {
+	return ToMapNonZero(v)
+}
+
+func ToUint8MapNonZeroOf[K comparable](v map[K]uint8) map[K]*uint8 {
+	return ToMapNonZero(v)
+}
+
+func ParseUint8(s string, opts ...ParseOption) (*uint8, error) {
+	return Parse[uint8](s, opts...)
+}
+
+func Uint16(v uint16) *uint16 {
+	return To(v)
+}
+
+func Uint16Slice(v []uint16) []*uint16 {
+	return ToSlice(v)
+}
+
+func Uint16Map(v map[string]uint16) map[string]*uint16 {
+	return ToMap(v)
+}
+
+func Uint16MapOf[K comparable](v map[K]uint16) map[K]*uint16 {
+	return ToMap(v)
+}
+
+func Uint16Value(v *uint16) uint16 {
+	return Value(v)
+}
+
+func Uint16ValueSlice(v []*uint16) []uint16 {
+	return ValueSlice(v)
+}
+
+func Uint16ValueMap(v map[string]*uint16) map[string]uint16 {
+	return ValueMap(v)
+}
+
+func Uint16ValueMapOf[K comparable](v map[K]*uint16) map[K]uint16 {
+	return ValueMap(v)
+}
+
+func Uint16ValueOr(v *uint16, def uint16) uint16 {
+	return ValueOr(v, def)
+}
+
+func Uint16ValueSliceOr(v []*uint16, def uint16) []uint16 {
+	return ValueSliceOr(v, def)
+}
+
+func Uint16ValueMapOr(v map[string]*uint16, def uint16) map[string]uint16 {
+	return ValueMapOr(v, def)
+}
+
+func Uint16ValueMapOrOf[K comparable](v map[K]*uint16, def uint16) map[K]uint16 {
+	return ValueMapOr(v, def)
+}
+
+func Uint16ValueSliceWith(v []*uint16, opts ...NilOption[uint16]) ([]uint16, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Uint16ValueMapWith(v map[string]*uint16, opts ...NilOption[uint16]) (map[string]uint16, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Uint16ValueMapWithOf[K comparable](v map[K]*uint16, opts ...NilOption[uint16]) (map[K]uint16, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToUint16NonZero(v uint16) *uint16 {
+	return ToNonZero(v)
+}
+
+func ToUint16SliceNonZero(v []uint16) []*uint16 {
+	return ToSliceNonZero(v)
+}
+
+func ToUint16MapNonZero(v map[string]uint16) map[string]*uint16 {
+	return ToMapNonZero(v)
+}
+
+func ToUint16MapNonZeroOf[K comparable](v map[K]uint16) map[K]*uint16 {
+	return ToMapNonZero(v)
+}
+
+func ParseUint16(s string, opts ...ParseOption) (*uint16, error) {
+	return Parse[uint16](s, opts...)
+}
+
+func Uint32(v uint32) *uint32 {
+	return To(v)
+}
+
+func Uint32Slice(v []uint32) []*uint32 {
+	return ToSlice(v)
+}
+
+func Uint32Map(v map[string]uint32) map[string]*uint32 {
+	return ToMap(v)
+}
+
+func Uint32MapOf[K comparable](v map[K]uint32) map[K]*uint32 {
+	return ToMap(v)
+}
+
+func Uint32Value(v *uint32) uint32 {
+	return Value(v)
+}
+
+func Uint32ValueSlice(v []*uint32) []uint32 {
+	return ValueSlice(v)
+}
+
+func Uint32ValueMap(v map[string]*uint32) map[string]uint32 {
+	return ValueMap(v)
+}
+
+func Uint32ValueMapOf[K comparable](v map[K]*uint32) map[K]uint32 {
+	return ValueMap(v)
+}
+
+func Uint32ValueOr(v *uint32, def uint32) uint32 {
+	return ValueOr(v, def)
+}
+
+func Uint32ValueSliceOr(v []*uint32, def uint32) []uint32 {
+	return ValueSliceOr(v, def)
+}
+
+func Uint32ValueMapOr(v map[string]*uint32, def uint32) map[string]uint32 {
+	return ValueMapOr(v, def)
+}
+
+func Uint32ValueMapOrOf[K comparable](v map[K]*uint32, def uint32) map[K]uint32 {
+	return ValueMapOr(v, def)
+}
+
+func Uint32ValueSliceWith(v []*uint32, opts ...NilOption[uint32]) ([]uint32, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Uint32ValueMapWith(v map[string]*uint32, opts ...NilOption[uint32]) (map[string]uint32, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Uint32ValueMapWithOf[K comparable](v map[K]*uint32, opts ...NilOption[uint32]) (map[K]uint32, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToUint32NonZero(v uint32) *uint32 {
+	return ToNonZero(v)
+}
+
+func ToUint32SliceNonZero(v []uint32) []*uint32 {
+	return ToSliceNonZero(v)
+}
+
+func ToUint32MapNonZero(v map[string]uint32) map[string]*uint32 {
+	return ToMapNonZero(v)
+}
+
+func ToUint32MapNonZeroOf[K comparable](v map[K]uint32) map[K]*uint32 {
+	return ToMapNonZero(v)
+}
+
+func ParseUint32(s string, opts ...ParseOption) (*uint32, error) {
+	return Parse[uint32](s, opts...)
+}
+
+func Uint64(v uint64) *uint64 {
+	return To(v)
+}
+
+func Uint64Slice(v []uint64) []*uint64 {
+	return ToSlice(v)
+}
+
+func Uint64Map(v map[string]uint64) map[string]*uint64 {
+	return ToMap(v)
+}
+
+func Uint64MapOf[K comparable](v map[K]uint64) map[K]*uint64 {
+	return ToMap(v)
+}
+
+func Uint64Value(v *uint64) uint64 {
+	return Value(v)
+}
+
+func Uint64ValueSlice(v []*uint64) []uint64 {
+	return ValueSlice(v)
+}
+
+func Uint64ValueMap(v map[string]*uint64) map[string]uint64 {
+	return ValueMap(v)
+}
+
+func Uint64ValueMapOf[K comparable](v map[K]*uint64) map[K]uint64 {
+	return ValueMap(v)
+}
+
+func Uint64ValueOr(v *uint64, def uint64) uint64 {
+	return ValueOr(v, def)
+}
+
+func Uint64ValueSliceOr(v []*uint64, def uint64) []uint64 {
+	return ValueSliceOr(v, def)
+}
+
+func Uint64ValueMapOr(v map[string]*uint64, def uint64) map[string]uint64 {
+	return ValueMapOr(v, def)
+}
+
+func Uint64ValueMapOrOf[K comparable](v map[K]*uint64, def uint64) map[K]uint64 {
+	return ValueMapOr(v, def)
+}
+
+func Uint64ValueSliceWith(v []*uint64, opts ...NilOption[uint64]) ([]uint64, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Uint64ValueMapWith(v map[string]*uint64, opts ...NilOption[uint64]) (map[string]uint64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Uint64ValueMapWithOf[K comparable](v map[K]*uint64, opts ...NilOption[uint64]) (map[K]uint64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToUint64NonZero(v uint64) *uint64 {
+	return ToNonZero(v)
+}
+
+func ToUint64SliceNonZero(v []uint64) []*uint64 {
+	return ToSliceNonZero(v)
+}
+
+func ToUint64MapNonZero(v map[string]uint64) map[string]*uint64 {
+	return ToMapNonZero(v)
+}
+
+func ToUint64MapNonZeroOf[K comparable](v map[K]uint64) map[K]*uint64 {
+	return ToMapNonZero(v)
+}
+
+func ParseUint64(s string, opts ...ParseOption) (*uint64, error) {
+	return Parse[uint64](s, opts...)
+}
+
+func Uintptr(v uintptr) *uintptr {
+	return To(v)
+}
+
+func UintptrSlice(v []uintptr) []*uintptr {
+	return ToSlice(v)
+}
+
+func UintptrMap(v map[string]uintptr) map[string]*uintptr {
+	return ToMap(v)
+}
+
+func UintptrMapOf[K comparable](v map[K]uintptr) map[K]*uintptr {
+	return ToMap(v)
+}
+
+func UintptrValue(v *uintptr) uintptr {
+	return Value(v)
+}
+
+func UintptrValueSlice(v []*uintptr) []uintptr {
+	return ValueSlice(v)
+}
+
+func UintptrValueMap(v map[string]*uintptr) map[string]uintptr {
+	return ValueMap(v)
+}
+
+func UintptrValueMapOf[K comparable](v map[K]*uintptr) map[K]uintptr {
+	return ValueMap(v)
+}
+
+func UintptrValueOr(v *uintptr, def uintptr) uintptr {
+	return ValueOr(v, def)
+}
+
+func UintptrValueSliceOr(v []*uintptr, def uintptr) []uintptr {
+	return ValueSliceOr(v, def)
+}
+
+func UintptrValueMapOr(v map[string]*uintptr, def uintptr) map[string]uintptr {
+	return ValueMapOr(v, def)
+}
+
+func UintptrValueMapOrOf[K comparable](v map[K]*uintptr, def uintptr) map[K]uintptr {
+	return ValueMapOr(v, def)
+}
+
+func UintptrValueSliceWith(v []*uintptr, opts ...NilOption[uintptr]) ([]uintptr, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func UintptrValueMapWith(v map[string]*uintptr, opts ...NilOption[uintptr]) (map[string]uintptr, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func UintptrValueMapWithOf[K comparable](v map[K]*uintptr, opts ...NilOption[uintptr]) (map[K]uintptr, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToUintptrNonZero(v uintptr) *uintptr {
+	return ToNonZero(v)
+}
+
+func ToUintptrSliceNonZero(v []uintptr) []*uintptr {
+	return ToSliceNonZero(v)
+}
+
+func ToUintptrMapNonZero(v map[string]uintptr) map[string]*uintptr {
+	return ToMapNonZero(v)
+}
+
+func ToUintptrMapNonZeroOf[K comparable](v map[K]uintptr) map[K]*uintptr {
+	return ToMapNonZero(v)
+}
+
+func ParseUintptr(s string, opts ...ParseOption) (*uintptr, error) {
+	return Parse[uintptr](s, opts...)
+}
+
+func Float32(v float32) *float32 {
+	return To(v)
+}
+
+func Float32Slice(v []float32) []*float32 {
+	return ToSlice(v)
+}
+
+func Float32Map(v map[string]float32) map[string]*float32 {
+	return ToMap(v)
+}
+
+func Float32MapOf[K comparable](v map[K]float32) map[K]*float32 {
+	return ToMap(v)
+}
+
+func Float32Value(v *float32) float32 {
+	return Value(v)
+}
+
+func Float32ValueSlice(v []*float32) []float32 {
+	return ValueSlice(v)
+}
+
+func Float32ValueMap(v map[string]*float32) map[string]float32 {
+	return ValueMap(v)
+}
+
+func Float32ValueMapOf[K comparable](v map[K]*float32) map[K]float32 {
+	return ValueMap(v)
+}
+
+func Float32ValueOr(v *float32, def float32) float32 {
+	return ValueOr(v, def)
+}
+
+func Float32ValueSliceOr(v []*float32, def float32) []float32 {
+	return ValueSliceOr(v, def)
+}
+
+func Float32ValueMapOr(v map[string]*float32, def float32) map[string]float32 {
+	return ValueMapOr(v, def)
+}
+
+func Float32ValueMapOrOf[K comparable](v map[K]*float32, def float32) map[K]float32 {
+	return ValueMapOr(v, def)
+}
+
+func Float32ValueSliceWith(v []*float32, opts ...NilOption[float32]) ([]float32, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Float32ValueMapWith(v map[string]*float32, opts ...NilOption[float32]) (map[string]float32, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Float32ValueMapWithOf[K comparable](v map[K]*float32, opts ...NilOption[float32]) (map[K]float32, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToFloat32NonZero(v float32) *float32 {
+	return ToNonZero(v)
+}
+
+func ToFloat32SliceNonZero(v []float32) []*float32 {
+	return ToSliceNonZero(v)
+}
+
+func ToFloat32MapNonZero(v map[string]float32) map[string]*float32 {
+	return ToMapNonZero(v)
+}
+
+func ToFloat32MapNonZeroOf[K comparable](v map[K]float32) map[K]*float32 {
+	return ToMapNonZero(v)
+}
+
+func ParseFloat32(s string, opts ...ParseOption) (*float32, error) {
+	return Parse[float32](s, opts...)
+}
+
+func Float64(v float64) *float64 {
+	return To(v)
+}
+
+func Float64Slice(v []float64) []*float64 {
+	return ToSlice(v)
+}
+
+func Float64Map(v map[string]float64) map[string]*float64 {
+	return ToMap(v)
+}
+
+func Float64MapOf[K comparable](v map[K]float64) map[K]*float64 {
+	return ToMap(v)
+}
+
+func Float64Value(v *float64) float64 {
+	return Value(v)
+}
+
+func Float64ValueSlice(v []*float64) []float64 {
+	return ValueSlice(v)
+}
+
+func Float64ValueMap(v map[string]*float64) map[string]float64 {
+	return ValueMap(v)
+}
+
+func Float64ValueMapOf[K comparable](v map[K]*float64) map[K]float64 {
+	return ValueMap(v)
+}
+
+func Float64ValueOr(v *float64, def float64) float64 {
+	return ValueOr(v, def)
+}
+
+func Float64ValueSliceOr(v []*float64, def float64) []float64 {
+	return ValueSliceOr(v, def)
+}
+
+func Float64ValueMapOr(v map[string]*float64, def float64) map[string]float64 {
+	return ValueMapOr(v, def)
+}
+
+func Float64ValueMapOrOf[K comparable](v map[K]*float64, def float64) map[K]float64 {
+	return ValueMapOr(v, def)
+}
+
+func Float64ValueSliceWith(v []*float64, opts ...NilOption[float64]) ([]float64, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Float64ValueMapWith(v map[string]*float64, opts ...NilOption[float64]) (map[string]float64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Float64ValueMapWithOf[K comparable](v map[K]*float64, opts ...NilOption[float64]) (map[K]float64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToFloat64NonZero(v float64) *float64 {
+	return ToNonZero(v)
+}
+
+func ToFloat64SliceNonZero(v []float64) []*float64 {
+	return ToSliceNonZero(v)
+}
+
+func ToFloat64MapNonZero(v map[string]float64) map[string]*float64 {
+	return ToMapNonZero(v)
+}
+
+func ToFloat64MapNonZeroOf[K comparable](v map[K]float64) map[K]*float64 {
+	return ToMapNonZero(v)
+}
+
+func ParseFloat64(s string, opts ...ParseOption) (*float64, error) {
+	return Parse[float64](s, opts...)
+}
+
+func Complex64(v complex64) *complex64 {
+	return To(v)
+}
+
+func Complex64Slice(v []complex64) []*complex64 {
+	return ToSlice(v)
+}
+
+func Complex64Map(v map[string]complex64) map[string]*complex64 {
+	return ToMap(v)
+}
+
+func Complex64MapOf[K comparable](v map[K]complex64) map[K]*complex64 {
+	return ToMap(v)
+}
+
+func Complex64Value(v *complex64) complex64 {
+	return Value(v)
+}
+
+func Complex64ValueSlice(v []*complex64) []complex64 {
+	return ValueSlice(v)
+}
+
+func Complex64ValueMap(v map[string]*complex64) map[string]complex64 {
+	return ValueMap(v)
+}
+
+func Complex64ValueMapOf[K comparable](v map[K]*complex64) map[K]complex64 {
+	return ValueMap(v)
+}
+
+func Complex64ValueOr(v *complex64, def complex64) complex64 {
+	return ValueOr(v, def)
+}
+
+func Complex64ValueSliceOr(v []*complex64, def complex64) []complex64 {
+	return ValueSliceOr(v, def)
+}
+
+func Complex64ValueMapOr(v map[string]*complex64, def complex64) map[string]complex64 {
+	return ValueMapOr(v, def)
+}
+
+func Complex64ValueMapOrOf[K comparable](v map[K]*complex64, def complex64) map[K]complex64 {
+	return ValueMapOr(v, def)
+}
+
+func Complex64ValueSliceWith(v []*complex64, opts ...NilOption[complex64]) ([]complex64, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Complex64ValueMapWith(v map[string]*complex64, opts ...NilOption[complex64]) (map[string]complex64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Complex64ValueMapWithOf[K comparable](v map[K]*complex64, opts ...NilOption[complex64]) (map[K]complex64, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToComplex64NonZero(v complex64) *complex64 {
+	return ToNonZero(v)
+}
+
+func ToComplex64SliceNonZero(v []complex64) []*complex64 {
+	return ToSliceNonZero(v)
+}
+
+func ToComplex64MapNonZero(v map[string]complex64) map[string]*complex64 {
+	return ToMapNonZero(v)
+}
+
+func ToComplex64MapNonZeroOf[K comparable](v map[K]complex64) map[K]*complex64 {
+	return ToMapNonZero(v)
+}
+
+func ParseComplex64(s string, opts ...ParseOption) (*complex64, error) {
+	return Parse[complex64](s, opts...)
+}
+
+func Complex128(v complex128) *complex128 {
+	return To(v)
+}
+
+func Complex128Slice(v []complex128) []*complex128 {
+	return ToSlice(v)
+}
+
+func Complex128Map(v map[string]complex128) map[string]*complex128 {
+	return ToMap(v)
+}
+
+func Complex128MapOf[K comparable](v map[K]complex128) map[K]*complex128 {
+	return ToMap(v)
+}
+
+func Complex128Value(v *complex128) complex128 {
+	return Value(v)
+}
+
+func Complex128ValueSlice(v []*complex128) []complex128 {
+	return ValueSlice(v)
+}
+
+func Complex128ValueMap(v map[string]*complex128) map[string]complex128 {
+	return ValueMap(v)
+}
+
+func Complex128ValueMapOf[K comparable](v map[K]*complex128) map[K]complex128 {
+	return ValueMap(v)
+}
+
+func Complex128ValueOr(v *complex128, def complex128) complex128 {
+	return ValueOr(v, def)
+}
+
+func Complex128ValueSliceOr(v []*complex128, def complex128) []complex128 {
+	return ValueSliceOr(v, def)
+}
+
+func Complex128ValueMapOr(v map[string]*complex128, def complex128) map[string]complex128 {
+	return ValueMapOr(v, def)
+}
+
+func Complex128ValueMapOrOf[K comparable](v map[K]*complex128, def complex128) map[K]complex128 {
+	return ValueMapOr(v, def)
+}
+
+func Complex128ValueSliceWith(v []*complex128, opts ...NilOption[complex128]) ([]complex128, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func Complex128ValueMapWith(v map[string]*complex128, opts ...NilOption[complex128]) (map[string]complex128, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func Complex128ValueMapWithOf[K comparable](v map[K]*complex128, opts ...NilOption[complex128]) (map[K]complex128, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToComplex128NonZero(v complex128) *complex128 {
+	return ToNonZero(v)
+}
+
+func ToComplex128SliceNonZero(v []complex128) []*complex128 {
+	return ToSliceNonZero(v)
+}
+
+func ToComplex128MapNonZero(v map[string]complex128) map[string]*complex128 {
+	return ToMapNonZero(v)
+}
+
+func ToComplex128MapNonZeroOf[K comparable](v map[K]complex128) map[K]*complex128 {
+	return ToMapNonZero(v)
+}
+
+func ParseComplex128(s string, opts ...ParseOption) (*complex128, error) {
+	return Parse[complex128](s, opts...)
+}
+
+func Time(v time.Time) *time.Time {
+	return To(v)
+}
+
+func TimeSlice(v []time.Time) []*time.Time {
+	return ToSlice(v)
+}
+
+func TimeMap(v map[string]time.Time) map[string]*time.Time {
+	return ToMap(v)
+}
+
+func TimeMapOf[K comparable](v map[K]time.Time) map[K]*time.Time {
+	return ToMap(v)
+}
+
+func TimeValue(v *time.Time) time.Time {
+	return Value(v)
+}
+
+func TimeValueSlice(v []*time.Time) []time.Time {
+	return ValueSlice(v)
+}
+
+func TimeValueMap(v map[string]*time.Time) map[string]time.Time {
+	return ValueMap(v)
+}
+
+func TimeValueMapOf[K comparable](v map[K]*time.Time) map[K]time.Time {
+	return ValueMap(v)
+}
+
+func TimeValueOr(v *time.Time, def time.Time) time.Time {
+	return ValueOr(v, def)
+}
+
+func TimeValueSliceOr(v []*time.Time, def time.Time) []time.Time {
+	return ValueSliceOr(v, def)
+}
+
+func TimeValueMapOr(v map[string]*time.Time, def time.Time) map[string]time.Time {
+	return ValueMapOr(v, def)
+}
+
+func TimeValueMapOrOf[K comparable](v map[K]*time.Time, def time.Time) map[K]time.Time {
+	return ValueMapOr(v, def)
+}
+
+func TimeValueSliceWith(v []*time.Time, opts ...NilOption[time.Time]) ([]time.Time, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func TimeValueMapWith(v map[string]*time.Time, opts ...NilOption[time.Time]) (map[string]time.Time, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func TimeValueMapWithOf[K comparable](v map[K]*time.Time, opts ...NilOption[time.Time]) (map[K]time.Time, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToTimeNonZero(v time.Time) *time.Time {
+	return ToNonZeroFunc(v, time.Time.IsZero)
+}
+
+func ToTimeSliceNonZero(v []time.Time) []*time.Time {
+	return ToSliceNonZeroFunc(v, time.Time.IsZero)
+}
+
+func ToTimeMapNonZero(v map[string]time.Time) map[string]*time.Time {
+	return ToMapNonZeroFunc(v, time.Time.IsZero)
+}
+
+func ToTimeMapNonZeroOf[K comparable](v map[K]time.Time) map[K]*time.Time {
+	return ToMapNonZeroFunc(v, time.Time.IsZero)
+}
+
+func ParseTime(s string, opts ...ParseOption) (*time.Time, error) {
+	return Parse[time.Time](s, opts...)
+}
+
+func Duration(v time.Duration) *time.Duration {
+	return To(v)
+}
+
+func DurationSlice(v []time.Duration) []*time.Duration {
+	return ToSlice(v)
+}
+
+func DurationMap(v map[string]time.Duration) map[string]*time.Duration {
+	return ToMap(v)
+}
+
+func DurationMapOf[K comparable](v map[K]time.Duration) map[K]*time.Duration {
+	return ToMap(v)
+}
+
+func DurationValue(v *time.Duration) time.Duration {
+	return Value(v)
+}
+
+func DurationValueSlice(v []*time.Duration) []time.Duration {
+	return ValueSlice(v)
+}
+
+func DurationValueMap(v map[string]*time.Duration) map[string]time.Duration {
+	return ValueMap(v)
+}
+
+func DurationValueMapOf[K comparable](v map[K]*time.Duration) map[K]time.Duration {
+	return ValueMap(v)
+}
+
+func DurationValueOr(v *time.Duration, def time.Duration) time.Duration {
+	return ValueOr(v, def)
+}
+
+func DurationValueSliceOr(v []*time.Duration, def time.Duration) []time.Duration {
+	return ValueSliceOr(v, def)
+}
+
+func DurationValueMapOr(v map[string]*time.Duration, def time.Duration) map[string]time.Duration {
+	return ValueMapOr(v, def)
+}
+
+func DurationValueMapOrOf[K comparable](v map[K]*time.Duration, def time.Duration) map[K]time.Duration {
+	return ValueMapOr(v, def)
+}
+
+func DurationValueSliceWith(v []*time.Duration, opts ...NilOption[time.Duration]) ([]time.Duration, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func DurationValueMapWith(v map[string]*time.Duration, opts ...NilOption[time.Duration]) (map[string]time.Duration, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func DurationValueMapWithOf[K comparable](v map[K]*time.Duration, opts ...NilOption[time.Duration]) (map[K]time.Duration, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToDurationNonZero(v time.Duration) *time.Duration {
+	return ToNonZero(v)
+}
+
+func ToDurationSliceNonZero(v []time.Duration) []*time.Duration {
+	return ToSliceNonZero(v)
+}
+
+func ToDurationMapNonZero(v map[string]time.Duration) map[string]*time.Duration {
+	return ToMapNonZero(v)
+}
+
+func ToDurationMapNonZeroOf[K comparable](v map[K]time.Duration) map[K]*time.Duration {
+	return ToMapNonZero(v)
+}
+
+func ParseDuration(s string, opts ...ParseOption) (*time.Duration, error) {
+	return Parse[time.Duration](s, opts...)
+}
+
+func Month(v time.Month) *time.Month {
+	return To(v)
+}
+
+func MonthSlice(v []time.Month) []*time.Month {
+	return ToSlice(v)
+}
+
+func MonthMap(v map[string]time.Month) map[string]*time.Month {
+	return ToMap(v)
+}
+
+func MonthMapOf[K comparable](v map[K]time.Month) map[K]*time.Month {
+	return ToMap(v)
+}
+
+func MonthValue(v *time.Month) time.Month {
+	return Value(v)
+}
+
+func MonthValueSlice(v []*time.Month) []time.Month {
+	return ValueSlice(v)
+}
+
+func MonthValueMap(v map[string]*time.Month) map[string]time.Month {
+	return ValueMap(v)
+}
+
+func MonthValueMapOf[K comparable](v map[K]*time.Month) map[K]time.Month {
+	return ValueMap(v)
+}
+
+func MonthValueOr(v *time.Month, def time.Month) time.Month {
+	return ValueOr(v, def)
+}
+
+func MonthValueSliceOr(v []*time.Month, def time.Month) []time.Month {
+	return ValueSliceOr(v, def)
+}
+
+func MonthValueMapOr(v map[string]*time.Month, def time.Month) map[string]time.Month {
+	return ValueMapOr(v, def)
+}
+
+func MonthValueMapOrOf[K comparable](v map[K]*time.Month, def time.Month) map[K]time.Month {
+	return ValueMapOr(v, def)
+}
+
+func MonthValueSliceWith(v []*time.Month, opts ...NilOption[time.Month]) ([]time.Month, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func MonthValueMapWith(v map[string]*time.Month, opts ...NilOption[time.Month]) (map[string]time.Month, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func MonthValueMapWithOf[K comparable](v map[K]*time.Month, opts ...NilOption[time.Month]) (map[K]time.Month, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToMonthNonZero(v time.Month) *time.Month {
+	return ToNonZero(v)
+}
+
+func ToMonthSliceNonZero(v []time.Month) []*time.Month {
+	return ToSliceNonZero(v)
+}
+
+func ToMonthMapNonZero(v map[string]time.Month) map[string]*time.Month {
+	return ToMapNonZero(v)
+}
+
+func ToMonthMapNonZeroOf[K comparable](v map[K]time.Month) map[K]*time.Month {
+	return ToMapNonZero(v)
+}
+
+func ParseMonth(s string, opts ...ParseOption) (*time.Month, error) {
+	return Parse[time.Month](s, opts...)
+}
+
+func Weekday(v time.Weekday) *time.Weekday {
+	return To(v)
+}
+
+func WeekdaySlice(v []time.Weekday) []*time.Weekday {
+	return ToSlice(v)
+}
+
+func WeekdayMap(v map[string]time.Weekday) map[string]*time.Weekday {
+	return ToMap(v)
+}
+
+func WeekdayMapOf[K comparable](v map[K]time.Weekday) map[K]*time.Weekday {
+	return ToMap(v)
+}
+
+func WeekdayValue(v *time.Weekday) time.Weekday {
+	return Value(v)
+}
+
+func WeekdayValueSlice(v []*time.Weekday) []time.Weekday {
+	return ValueSlice(v)
+}
+
+func WeekdayValueMap(v map[string]*time.Weekday) map[string]time.Weekday {
+	return ValueMap(v)
+}
+
+func WeekdayValueMapOf[K comparable](v map[K]*time.Weekday) map[K]time.Weekday {
+	return ValueMap(v)
+}
+
+func WeekdayValueOr(v *time.Weekday, def time.Weekday) time.Weekday {
+	return ValueOr(v, def)
+}
+
+func WeekdayValueSliceOr(v []*time.Weekday, def time.Weekday) []time.Weekday {
+	return ValueSliceOr(v, def)
+}
+
+func WeekdayValueMapOr(v map[string]*time.Weekday, def time.Weekday) map[string]time.Weekday {
+	return ValueMapOr(v, def)
+}
+
+func WeekdayValueMapOrOf[K comparable](v map[K]*time.Weekday, def time.Weekday) map[K]time.Weekday {
+	return ValueMapOr(v, def)
+}
+
+func WeekdayValueSliceWith(v []*time.Weekday, opts ...NilOption[time.Weekday]) ([]time.Weekday, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func WeekdayValueMapWith(v map[string]*time.Weekday, opts ...NilOption[time.Weekday]) (map[string]time.Weekday, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func WeekdayValueMapWithOf[K comparable](v map[K]*time.Weekday, opts ...NilOption[time.Weekday]) (map[K]time.Weekday, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToWeekdayNonZero(v time.Weekday) *time.Weekday {
+	return ToNonZero(v)
+}
+
+func ToWeekdaySliceNonZero(v []time.Weekday) []*time.Weekday {
+	return ToSliceNonZero(v)
+}
+
+func ToWeekdayMapNonZero(v map[string]time.Weekday) map[string]*time.Weekday {
+	return ToMapNonZero(v)
+}
+
+func ToWeekdayMapNonZeroOf[K comparable](v map[K]time.Weekday) map[K]*time.Weekday {
+	return ToMapNonZero(v)
+}
+
+func ParseWeekday(s string, opts ...ParseOption) (*time.Weekday, error) {
+	return Parse[time.Weekday](s, opts...)
+}
+
+func RawMessage(v json.RawMessage) *json.RawMessage {
+	return To(v)
+}
+
+func RawMessageSlice(v []json.RawMessage) []*json.RawMessage {
+	return ToSlice(v)
+}
+
+func RawMessageMap(v map[string]json.RawMessage) map[string]*json.RawMessage {
+	return ToMap(v)
+}
+
+func RawMessageMapOf[K comparable](v map[K]json.RawMessage) map[K]*json.RawMessage {
+	return ToMap(v)
+}
+
+func RawMessageValue(v *json.RawMessage) json.RawMessage {
+	return Value(v)
+}
+
+func RawMessageValueSlice(v []*json.RawMessage) []json.RawMessage {
+	return ValueSlice(v)
+}
+
+func RawMessageValueMap(v map[string]*json.RawMessage) map[string]json.RawMessage {
+	return ValueMap(v)
+}
+
+func RawMessageValueMapOf[K comparable](v map[K]*json.RawMessage) map[K]json.RawMessage {
+	return ValueMap(v)
+}
+
+func RawMessageValueOr(v *json.RawMessage, def json.RawMessage) json.RawMessage {
+	return ValueOr(v, def)
+}
+
+func RawMessageValueSliceOr(v []*json.RawMessage, def json.RawMessage) []json.RawMessage {
+	return ValueSliceOr(v, def)
+}
+
+func RawMessageValueMapOr(v map[string]*json.RawMessage, def json.RawMessage) map[string]json.RawMessage {
+	return ValueMapOr(v, def)
+}
+
+func RawMessageValueMapOrOf[K comparable](v map[K]*json.RawMessage, def json.RawMessage) map[K]json.RawMessage {
+	return ValueMapOr(v, def)
+}
+
+func RawMessageValueSliceWith(v []*json.RawMessage, opts ...NilOption[json.RawMessage]) ([]json.RawMessage, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func RawMessageValueMapWith(v map[string]*json.RawMessage, opts ...NilOption[json.RawMessage]) (map[string]json.RawMessage, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func RawMessageValueMapWithOf[K comparable](v map[K]*json.RawMessage, opts ...NilOption[json.RawMessage]) (map[K]json.RawMessage, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToRawMessageNonEmpty(v json.RawMessage) *json.RawMessage {
+	return ToNonZeroFunc(v, func(v json.RawMessage) bool { return len(v) == 0 })
+}
+
+func ToRawMessageSliceNonEmpty(v []json.RawMessage) []*json.RawMessage {
+	return ToSliceNonZeroFunc(v, func(v json.RawMessage) bool { return len(v) == 0 })
+}
+
+func ToRawMessageMapNonEmpty(v map[string]json.RawMessage) map[string]*json.RawMessage {
+	return ToMapNonZeroFunc(v, func(v json.RawMessage) bool { return len(v) == 0 })
+}
+
+func ToRawMessageMapNonEmptyOf[K comparable](v map[K]json.RawMessage) map[K]*json.RawMessage {
+	return ToMapNonZeroFunc(v, func(v json.RawMessage) bool { return len(v) == 0 })
+}
+
+func ParseRawMessage(s string, opts ...ParseOption) (*json.RawMessage, error) {
+	return Parse[json.RawMessage](s, opts...)
+}
+
+func NetipAddr(v netip.Addr) *netip.Addr {
+	return To(v)
+}
+
+func NetipAddrSlice(v []netip.Addr) []*netip.Addr {
+	return ToSlice(v)
+}
+
+func NetipAddrMap(v map[string]netip.Addr) map[string]*netip.Addr {
+	return ToMap(v)
+}
+
+func NetipAddrMapOf[K comparable](v map[K]netip.Addr) map[K]*netip.Addr {
+	return ToMap(v)
+}
+
+func NetipAddrValue(v *netip.Addr) netip.Addr {
+	return Value(v)
+}
+
+func NetipAddrValueSlice(v []*netip.Addr) []netip.Addr {
+	return ValueSlice(v)
+}
+
+func NetipAddrValueMap(v map[string]*netip.Addr) map[string]netip.Addr {
+	return ValueMap(v)
+}
+
+func NetipAddrValueMapOf[K comparable](v map[K]*netip.Addr) map[K]netip.Addr {
+	return ValueMap(v)
+}
+
+func NetipAddrValueOr(v *netip.Addr, def netip.Addr) netip.Addr {
+	return ValueOr(v, def)
+}
+
+func NetipAddrValueSliceOr(v []*netip.Addr, def netip.Addr) []netip.Addr {
+	return ValueSliceOr(v, def)
+}
+
+func NetipAddrValueMapOr(v map[string]*netip.Addr, def netip.Addr) map[string]netip.Addr {
+	return ValueMapOr(v, def)
+}
+
+func NetipAddrValueMapOrOf[K comparable](v map[K]*netip.Addr, def netip.Addr) map[K]netip.Addr {
+	return ValueMapOr(v, def)
+}
+
+func NetipAddrValueSliceWith(v []*netip.Addr, opts ...NilOption[netip.Addr]) ([]netip.Addr, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func NetipAddrValueMapWith(v map[string]*netip.Addr, opts ...NilOption[netip.Addr]) (map[string]netip.Addr, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func NetipAddrValueMapWithOf[K comparable](v map[K]*netip.Addr, opts ...NilOption[netip.Addr]) (map[K]netip.Addr, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToNetipAddrNonZero(v netip.Addr) *netip.Addr {
+	return ToNonZero(v)
+}
+
+func ToNetipAddrSliceNonZero(v []netip.Addr) []*netip.Addr {
+	return ToSliceNonZero(v)
+}
+
+func ToNetipAddrMapNonZero(v map[string]netip.Addr) map[string]*netip.Addr {
+	return ToMapNonZero(v)
+}
+
+func ToNetipAddrMapNonZeroOf[K comparable](v map[K]netip.Addr) map[K]*netip.Addr {
+	return ToMapNonZero(v)
+}
+
+func ParseNetipAddr(s string, opts ...ParseOption) (*netip.Addr, error) {
+	return Parse[netip.Addr](s, opts...)
+}
+
+func NetipAddrPort(v netip.AddrPort) *netip.AddrPort {
+	return To(v)
+}
+
+func NetipAddrPortSlice(v []netip.AddrPort) []*netip.AddrPort {
+	return ToSlice(v)
+}
+
+func NetipAddrPortMap(v map[string]netip.AddrPort) map[string]*netip.AddrPort {
+	return ToMap(v)
+}
+
+func NetipAddrPortMapOf[K comparable](v map[K]netip.AddrPort) map[K]*netip.AddrPort {
+	return ToMap(v)
+}
+
+func NetipAddrPortValue(v *netip.AddrPort) netip.AddrPort {
+	return Value(v)
+}
+
+func NetipAddrPortValueSlice(v []*netip.AddrPort) []netip.AddrPort {
+	return ValueSlice(v)
+}
+
+func NetipAddrPortValueMap(v map[string]*netip.AddrPort) map[string]netip.AddrPort {
+	return ValueMap(v)
+}
+
+func NetipAddrPortValueMapOf[K comparable](v map[K]*netip.AddrPort) map[K]netip.AddrPort {
+	return ValueMap(v)
+}
+
+func NetipAddrPortValueOr(v *netip.AddrPort, def netip.AddrPort) netip.AddrPort {
+	return ValueOr(v, def)
+}
+
+func NetipAddrPortValueSliceOr(v []*netip.AddrPort, def netip.AddrPort) []netip.AddrPort {
+	return ValueSliceOr(v, def)
+}
+
+func NetipAddrPortValueMapOr(v map[string]*netip.AddrPort, def netip.AddrPort) map[string]netip.AddrPort {
+	return ValueMapOr(v, def)
+}
+
+func NetipAddrPortValueMapOrOf[K comparable](v map[K]*netip.AddrPort, def netip.AddrPort) map[K]netip.AddrPort {
+	return ValueMapOr(v, def)
+}
+
+func NetipAddrPortValueSliceWith(v []*netip.AddrPort, opts ...NilOption[netip.AddrPort]) ([]netip.AddrPort, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func NetipAddrPortValueMapWith(v map[string]*netip.AddrPort, opts ...NilOption[netip.AddrPort]) (map[string]netip.AddrPort, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func NetipAddrPortValueMapWithOf[K comparable](v map[K]*netip.AddrPort, opts ...NilOption[netip.AddrPort]) (map[K]netip.AddrPort, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToNetipAddrPortNonZero(v netip.AddrPort) *netip.AddrPort {
+	return ToNonZero(v)
+}
+
+func ToNetipAddrPortSliceNonZero(v []netip.AddrPort) []*netip.AddrPort {
+	return ToSliceNonZero(v)
+}
+
+func ToNetipAddrPortMapNonZero(v map[string]netip.AddrPort) map[string]*netip.AddrPort {
+	return ToMapNonZero(v)
+}
+
+func ToNetipAddrPortMapNonZeroOf[K comparable](v map[K]netip.AddrPort) map[K]*netip.AddrPort {
+	return ToMapNonZero(v)
+}
+
+func ParseNetipAddrPort(s string, opts ...ParseOption) (*netip.AddrPort, error) {
+	return Parse[netip.AddrPort](s, opts...)
+}
+
+func NetipPrefix(v netip.Prefix) *netip.Prefix {
+	return To(v)
+}
+
+func NetipPrefixSlice(v []netip.Prefix) []*netip.Prefix {
+	return ToSlice(v)
+}
+
+func NetipPrefixMap(v map[string]netip.Prefix) map[string]*netip.Prefix {
+	return ToMap(v)
+}
+
+func NetipPrefixMapOf[K comparable](v map[K]netip.Prefix) map[K]*netip.Prefix {
+	return ToMap(v)
+}
+
+func NetipPrefixValue(v *netip.Prefix) netip.Prefix {
+	return Value(v)
+}
+
+func NetipPrefixValueSlice(v []*netip.Prefix) []netip.Prefix {
+	return ValueSlice(v)
+}
+
+func NetipPrefixValueMap(v map[string]*netip.Prefix) map[string]netip.Prefix {
+	return ValueMap(v)
+}
+
+func NetipPrefixValueMapOf[K comparable](v map[K]*netip.Prefix) map[K]netip.Prefix {
+	return ValueMap(v)
+}
+
+func NetipPrefixValueOr(v *netip.Prefix, def netip.Prefix) netip.Prefix {
+	return ValueOr(v, def)
+}
+
+func NetipPrefixValueSliceOr(v []*netip.Prefix, def netip.Prefix) []netip.Prefix {
+	return ValueSliceOr(v, def)
+}
+
+func NetipPrefixValueMapOr(v map[string]*netip.Prefix, def netip.Prefix) map[string]netip.Prefix {
+	return ValueMapOr(v, def)
+}
+
+func NetipPrefixValueMapOrOf[K comparable](v map[K]*netip.Prefix, def netip.Prefix) map[K]netip.Prefix {
+	return ValueMapOr(v, def)
+}
+
+func NetipPrefixValueSliceWith(v []*netip.Prefix, opts ...NilOption[netip.Prefix]) ([]netip.Prefix, error) {
+	return ValueSliceWith(v, opts...)
+}
+
+func NetipPrefixValueMapWith(v map[string]*netip.Prefix, opts ...NilOption[netip.Prefix]) (map[string]netip.Prefix, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func NetipPrefixValueMapWithOf[K comparable](v map[K]*netip.Prefix, opts ...NilOption[netip.Prefix]) (map[K]netip.Prefix, error) {
+	return ValueMapWith(v, opts...)
+}
+
+func ToNetipPrefixNonZero(v netip.Prefix) *netip.Prefix {
+	return ToNonZero(v)
+}
+
+func ToNetipPrefixSliceNonZero(v []netip.Prefix) []*netip.Prefix {
+	return ToSliceNonZero(v)
+}
+
+func ToNetipPrefixMapNonZero(v map[string]netip.Prefix) map[string]*netip.Prefix {
+	return ToMapNonZero(v)
+}
+
+func ToNetipPrefixMapNonZeroOf[K comparable](v map[K]netip.Prefix) map[K]*netip.Prefix {
+	return ToMapNonZero(v)
+}
+
+func ParseNetipPrefix(s string, opts ...ParseOption) (*netip.Prefix, error) {
+	return Parse[netip.Prefix](s, opts...)
+}
@@ -0,0 +1,5491 @@
+// Code generated by ptrgen; DO NOT EDIT.
+
+package ptr
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_String(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		value := "foo"
+
+		pointer := String(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("string/slice", func(t *testing.T) {
+		value := []string{"foo", "bar", "baz"}
+
+		pointer := StringSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("string/map", func(t *testing.T) {
+		value := map[string]string{
+			"foo": "foo",
+			"bar": "bar",
+			"baz": "baz",
+		}
+
+		pointer := StringMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("string/map/int64", func(t *testing.T) {
+		value := map[int64]string{
+			42: "foo",
+			69: "bar",
+		}
+
+		pointer := StringMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_StringValue(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		pointer := "foo"
+
+		value := StringValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("string/slice", func(t *testing.T) {
+		p1 := "foo"
+		p2 := "bar"
+		p3 := "baz"
+		pointer := []*string{&p1, &p2, &p3}
+
+		value := StringValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("string/map", func(t *testing.T) {
+		p1 := "foo"
+		p2 := "bar"
+		p3 := "baz"
+		pointer := map[string]*string{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := StringValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("string/map/int64", func(t *testing.T) {
+		p1 := "foo"
+		p2 := "bar"
+		pointer := map[int64]*string{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := StringValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_StringValueOr(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		pointer := "foo"
+
+		assert.Equal(t, pointer, StringValueOr(&pointer, "bar"))
+		assert.Equal(t, "bar", StringValueOr(nil, "bar"))
+	})
+
+	t.Run("string/slice", func(t *testing.T) {
+		p1 := "foo"
+		pointer := []*string{&p1, nil}
+
+		value := StringValueSliceOr(pointer, "bar")
+		assert.Equal(t, []string{"foo", "bar"}, value)
+	})
+
+	t.Run("string/map", func(t *testing.T) {
+		p1 := "foo"
+		pointer := map[string]*string{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := StringValueMapOr(pointer, "bar")
+		assert.Equal(t, map[string]string{"foo": "foo", "bar": "bar"}, value)
+	})
+
+	t.Run("string/map/int64", func(t *testing.T) {
+		p1 := "foo"
+		pointer := map[int64]*string{42: &p1, 69: nil}
+
+		value := StringValueMapOrOf(pointer, "bar")
+		assert.Equal(t, map[int64]string{42: "foo", 69: "bar"}, value)
+	})
+}
+
+func Test_StringValueWith(t *testing.T) {
+	t.Run("string/slice", func(t *testing.T) {
+		p1 := "foo"
+		pointer := []*string{&p1, nil}
+
+		value, err := StringValueSliceWith(pointer, DefaultNil("bar"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"foo", "bar"}, value)
+	})
+
+	t.Run("string/map", func(t *testing.T) {
+		p1 := "foo"
+		pointer := map[string]*string{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := StringValueMapWith(pointer, SkipNil[string]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"foo": "foo"}, value)
+	})
+
+	t.Run("string/map/int64", func(t *testing.T) {
+		p1 := "foo"
+		pointer := map[int64]*string{42: &p1, 69: nil}
+
+		value, err := StringValueMapWithOf(pointer, SkipNil[string]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]string{42: "foo"}, value)
+	})
+}
+
+func Test_ToStringNonEmpty(t *testing.T) {
+	var zero string
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "foo", *ToStringNonEmpty("foo"))
+		assert.Nil(t, ToStringNonEmpty(zero))
+	})
+
+	t.Run("string/slice", func(t *testing.T) {
+		pointer := ToStringSliceNonEmpty([]string{"foo", zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, "foo", *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("string/map", func(t *testing.T) {
+		pointer := ToStringMapNonEmpty(map[string]string{"foo": "foo", "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, "foo", *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("string/map/int64", func(t *testing.T) {
+		pointer := ToStringMapNonEmptyOf(map[int64]string{42: "foo", 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, "foo", *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseString(t *testing.T) {
+	pointer, err := ParseString(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Byte(t *testing.T) {
+	t.Run("byte", func(t *testing.T) {
+		value := byte(42)
+
+		pointer := Byte(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("byte/slice", func(t *testing.T) {
+		value := []byte{byte(42), byte(69), byte(99)}
+
+		pointer := ByteSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("byte/map", func(t *testing.T) {
+		value := map[string]byte{
+			"foo": byte(42),
+			"bar": byte(69),
+			"baz": byte(99),
+		}
+
+		pointer := ByteMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("byte/map/int64", func(t *testing.T) {
+		value := map[int64]byte{
+			42: byte(42),
+			69: byte(69),
+		}
+
+		pointer := ByteMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_ByteValue(t *testing.T) {
+	t.Run("byte", func(t *testing.T) {
+		pointer := byte(42)
+
+		value := ByteValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("byte/slice", func(t *testing.T) {
+		p1 := byte(42)
+		p2 := byte(69)
+		p3 := byte(99)
+		pointer := []*byte{&p1, &p2, &p3}
+
+		value := ByteValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("byte/map", func(t *testing.T) {
+		p1 := byte(42)
+		p2 := byte(69)
+		p3 := byte(99)
+		pointer := map[string]*byte{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := ByteValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("byte/map/int64", func(t *testing.T) {
+		p1 := byte(42)
+		p2 := byte(69)
+		pointer := map[int64]*byte{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := ByteValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_ByteValueOr(t *testing.T) {
+	t.Run("byte", func(t *testing.T) {
+		pointer := byte(42)
+
+		assert.Equal(t, pointer, ByteValueOr(&pointer, byte(69)))
+		assert.Equal(t, byte(69), ByteValueOr(nil, byte(69)))
+	})
+
+	t.Run("byte/slice", func(t *testing.T) {
+		p1 := byte(42)
+		pointer := []*byte{&p1, nil}
+
+		value := ByteValueSliceOr(pointer, byte(69))
+		assert.Equal(t, []byte{byte(42), byte(69)}, value)
+	})
+
+	t.Run("byte/map", func(t *testing.T) {
+		p1 := byte(42)
+		pointer := map[string]*byte{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := ByteValueMapOr(pointer, byte(69))
+		assert.Equal(t, map[string]byte{"foo": byte(42), "bar": byte(69)}, value)
+	})
+
+	t.Run("byte/map/int64", func(t *testing.T) {
+		p1 := byte(42)
+		pointer := map[int64]*byte{42: &p1, 69: nil}
+
+		value := ByteValueMapOrOf(pointer, byte(69))
+		assert.Equal(t, map[int64]byte{42: byte(42), 69: byte(69)}, value)
+	})
+}
+
+func Test_ByteValueWith(t *testing.T) {
+	t.Run("byte/slice", func(t *testing.T) {
+		p1 := byte(42)
+		pointer := []*byte{&p1, nil}
+
+		value, err := ByteValueSliceWith(pointer, DefaultNil(byte(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(42), byte(69)}, value)
+	})
+
+	t.Run("byte/map", func(t *testing.T) {
+		p1 := byte(42)
+		pointer := map[string]*byte{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := ByteValueMapWith(pointer, SkipNil[byte]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]byte{"foo": byte(42)}, value)
+	})
+
+	t.Run("byte/map/int64", func(t *testing.T) {
+		p1 := byte(42)
+		pointer := map[int64]*byte{42: &p1, 69: nil}
+
+		value, err := ByteValueMapWithOf(pointer, SkipNil[byte]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]byte{42: byte(42)}, value)
+	})
+}
+
+func Test_ToByteNonZero(t *testing.T) {
+	var zero byte
+
+	t.Run("byte", func(t *testing.T) {
+		assert.Equal(t, byte(42), *ToByteNonZero(byte(42)))
+		assert.Nil(t, ToByteNonZero(zero))
+	})
+
+	t.Run("byte/slice", func(t *testing.T) {
+		pointer := ToByteSliceNonZero([]byte{byte(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, byte(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("byte/map", func(t *testing.T) {
+		pointer := ToByteMapNonZero(map[string]byte{"foo": byte(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, byte(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("byte/map/int64", func(t *testing.T) {
+		pointer := ToByteMapNonZeroOf(map[int64]byte{42: byte(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, byte(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseByte(t *testing.T) {
+	pointer, err := ParseByte(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Rune(t *testing.T) {
+	t.Run("rune", func(t *testing.T) {
+		value := rune(42)
+
+		pointer := Rune(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("rune/slice", func(t *testing.T) {
+		value := []rune{rune(42), rune(69), rune(99)}
+
+		pointer := RuneSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("rune/map", func(t *testing.T) {
+		value := map[string]rune{
+			"foo": rune(42),
+			"bar": rune(69),
+			"baz": rune(99),
+		}
+
+		pointer := RuneMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("rune/map/int64", func(t *testing.T) {
+		value := map[int64]rune{
+			42: rune(42),
+			69: rune(69),
+		}
+
+		pointer := RuneMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_RuneValue(t *testing.T) {
+	t.Run("rune", func(t *testing.T) {
+		pointer := rune(42)
+
+		value := RuneValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("rune/slice", func(t *testing.T) {
+		p1 := rune(42)
+		p2 := rune(69)
+		p3 := rune(99)
+		pointer := []*rune{&p1, &p2, &p3}
+
+		value := RuneValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("rune/map", func(t *testing.T) {
+		p1 := rune(42)
+		p2 := rune(69)
+		p3 := rune(99)
+		pointer := map[string]*rune{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := RuneValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("rune/map/int64", func(t *testing.T) {
+		p1 := rune(42)
+		p2 := rune(69)
+		pointer := map[int64]*rune{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := RuneValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_RuneValueOr(t *testing.T) {
+	t.Run("rune", func(t *testing.T) {
+		pointer := rune(42)
+
+		assert.Equal(t, pointer, RuneValueOr(&pointer, rune(69)))
+		assert.Equal(t, rune(69), RuneValueOr(nil, rune(69)))
+	})
+
+	t.Run("rune/slice", func(t *testing.T) {
+		p1 := rune(42)
+		pointer := []*rune{&p1, nil}
+
+		value := RuneValueSliceOr(pointer, rune(69))
+		assert.Equal(t, []rune{rune(42), rune(69)}, value)
+	})
+
+	t.Run("rune/map", func(t *testing.T) {
+		p1 := rune(42)
+		pointer := map[string]*rune{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := RuneValueMapOr(pointer, rune(69))
+		assert.Equal(t, map[string]rune{"foo": rune(42), "bar": rune(69)}, value)
+	})
+
+	t.Run("rune/map/int64", func(t *testing.T) {
+		p1 := rune(42)
+		pointer := map[int64]*rune{42: &p1, 69: nil}
+
+		value := RuneValueMapOrOf(pointer, rune(69))
+		assert.Equal(t, map[int64]rune{42: rune(42), 69: rune(69)}, value)
+	})
+}
+
+func Test_RuneValueWith(t *testing.T) {
+	t.Run("rune/slice", func(t *testing.T) {
+		p1 := rune(42)
+		pointer := []*rune{&p1, nil}
+
+		value, err := RuneValueSliceWith(pointer, DefaultNil(rune(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []rune{rune(42), rune(69)}, value)
+	})
+
+	t.Run("rune/map", func(t *testing.T) {
+		p1 := rune(42)
+		pointer := map[string]*rune{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := RuneValueMapWith(pointer, SkipNil[rune]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]rune{"foo": rune(42)}, value)
+	})
+
+	t.Run("rune/map/int64", func(t *testing.T) {
+		p1 := rune(42)
+		pointer := map[int64]*rune{42: &p1, 69: nil}
+
+		value, err := RuneValueMapWithOf(pointer, SkipNil[rune]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]rune{42: rune(42)}, value)
+	})
+}
+
+func Test_ToRuneNonZero(t *testing.T) {
+	var zero rune
+
+	t.Run("rune", func(t *testing.T) {
+		assert.Equal(t, rune(42), *ToRuneNonZero(rune(42)))
+		assert.Nil(t, ToRuneNonZero(zero))
+	})
+
+	t.Run("rune/slice", func(t *testing.T) {
+		pointer := ToRuneSliceNonZero([]rune{rune(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, rune(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("rune/map", func(t *testing.T) {
+		pointer := ToRuneMapNonZero(map[string]rune{"foo": rune(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, rune(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("rune/map/int64", func(t *testing.T) {
+		pointer := ToRuneMapNonZeroOf(map[int64]rune{42: rune(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, rune(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseRune(t *testing.T) {
+	pointer, err := ParseRune(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Bool(t *testing.T) {
+	t.Run("bool", func(t *testing.T) {
+		value := true
+
+		pointer := Bool(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("bool/slice", func(t *testing.T) {
+		value := []bool{true, false, true}
+
+		pointer := BoolSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("bool/map", func(t *testing.T) {
+		value := map[string]bool{
+			"foo": true,
+			"bar": false,
+			"baz": true,
+		}
+
+		pointer := BoolMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("bool/map/int64", func(t *testing.T) {
+		value := map[int64]bool{
+			42: true,
+			69: false,
+		}
+
+		pointer := BoolMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_BoolValue(t *testing.T) {
+	t.Run("bool", func(t *testing.T) {
+		pointer := true
+
+		value := BoolValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("bool/slice", func(t *testing.T) {
+		p1 := true
+		p2 := false
+		p3 := true
+		pointer := []*bool{&p1, &p2, &p3}
+
+		value := BoolValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("bool/map", func(t *testing.T) {
+		p1 := true
+		p2 := false
+		p3 := true
+		pointer := map[string]*bool{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := BoolValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("bool/map/int64", func(t *testing.T) {
+		p1 := true
+		p2 := false
+		pointer := map[int64]*bool{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := BoolValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_BoolValueOr(t *testing.T) {
+	t.Run("bool", func(t *testing.T) {
+		pointer := true
+
+		assert.Equal(t, pointer, BoolValueOr(&pointer, false))
+		assert.Equal(t, false, BoolValueOr(nil, false))
+	})
+
+	t.Run("bool/slice", func(t *testing.T) {
+		p1 := true
+		pointer := []*bool{&p1, nil}
+
+		value := BoolValueSliceOr(pointer, false)
+		assert.Equal(t, []bool{true, false}, value)
+	})
+
+	t.Run("bool/map", func(t *testing.T) {
+		p1 := true
+		pointer := map[string]*bool{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := BoolValueMapOr(pointer, false)
+		assert.Equal(t, map[string]bool{"foo": true, "bar": false}, value)
+	})
+
+	t.Run("bool/map/int64", func(t *testing.T) {
+		p1 := true
+		pointer := map[int64]*bool{42: &p1, 69: nil}
+
+		value := BoolValueMapOrOf(pointer, false)
+		assert.Equal(t, map[int64]bool{42: true, 69: false}, value)
+	})
+}
+
+func Test_BoolValueWith(t *testing.T) {
+	t.Run("bool/slice", func(t *testing.T) {
+		p1 := true
+		pointer := []*bool{&p1, nil}
+
+		value, err := BoolValueSliceWith(pointer, DefaultNil(false))
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, value)
+	})
+
+	t.Run("bool/map", func(t *testing.T) {
+		p1 := true
+		pointer := map[string]*bool{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := BoolValueMapWith(pointer, SkipNil[bool]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"foo": true}, value)
+	})
+
+	t.Run("bool/map/int64", func(t *testing.T) {
+		p1 := true
+		pointer := map[int64]*bool{42: &p1, 69: nil}
+
+		value, err := BoolValueMapWithOf(pointer, SkipNil[bool]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]bool{42: true}, value)
+	})
+}
+
+func Test_ParseBool(t *testing.T) {
+	pointer, err := ParseBool(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Int(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		value := int(42)
+
+		pointer := Int(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("int/slice", func(t *testing.T) {
+		value := []int{int(42), int(69), int(99)}
+
+		pointer := IntSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int/map", func(t *testing.T) {
+		value := map[string]int{
+			"foo": int(42),
+			"bar": int(69),
+			"baz": int(99),
+		}
+
+		pointer := IntMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int/map/int64", func(t *testing.T) {
+		value := map[int64]int{
+			42: int(42),
+			69: int(69),
+		}
+
+		pointer := IntMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_IntValue(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		pointer := int(42)
+
+		value := IntValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("int/slice", func(t *testing.T) {
+		p1 := int(42)
+		p2 := int(69)
+		p3 := int(99)
+		pointer := []*int{&p1, &p2, &p3}
+
+		value := IntValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int/map", func(t *testing.T) {
+		p1 := int(42)
+		p2 := int(69)
+		p3 := int(99)
+		pointer := map[string]*int{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := IntValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int/map/int64", func(t *testing.T) {
+		p1 := int(42)
+		p2 := int(69)
+		pointer := map[int64]*int{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := IntValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_IntValueOr(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		pointer := int(42)
+
+		assert.Equal(t, pointer, IntValueOr(&pointer, int(69)))
+		assert.Equal(t, int(69), IntValueOr(nil, int(69)))
+	})
+
+	t.Run("int/slice", func(t *testing.T) {
+		p1 := int(42)
+		pointer := []*int{&p1, nil}
+
+		value := IntValueSliceOr(pointer, int(69))
+		assert.Equal(t, []int{int(42), int(69)}, value)
+	})
+
+	t.Run("int/map", func(t *testing.T) {
+		p1 := int(42)
+		pointer := map[string]*int{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := IntValueMapOr(pointer, int(69))
+		assert.Equal(t, map[string]int{"foo": int(42), "bar": int(69)}, value)
+	})
+
+	t.Run("int/map/int64", func(t *testing.T) {
+		p1 := int(42)
+		pointer := map[int64]*int{42: &p1, 69: nil}
+
+		value := IntValueMapOrOf(pointer, int(69))
+		assert.Equal(t, map[int64]int{42: int(42), 69: int(69)}, value)
+	})
+}
+
+func Test_IntValueWith(t *testing.T) {
+	t.Run("int/slice", func(t *testing.T) {
+		p1 := int(42)
+		pointer := []*int{&p1, nil}
+
+		value, err := IntValueSliceWith(pointer, DefaultNil(int(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []int{int(42), int(69)}, value)
+	})
+
+	t.Run("int/map", func(t *testing.T) {
+		p1 := int(42)
+		pointer := map[string]*int{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := IntValueMapWith(pointer, SkipNil[int]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"foo": int(42)}, value)
+	})
+
+	t.Run("int/map/int64", func(t *testing.T) {
+		p1 := int(42)
+		pointer := map[int64]*int{42: &p1, 69: nil}
+
+		value, err := IntValueMapWithOf(pointer, SkipNil[int]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int{42: int(42)}, value)
+	})
+}
+
+func Test_ToIntNonZero(t *testing.T) {
+	var zero int
+
+	t.Run("int", func(t *testing.T) {
+		assert.Equal(t, int(42), *ToIntNonZero(int(42)))
+		assert.Nil(t, ToIntNonZero(zero))
+	})
+
+	t.Run("int/slice", func(t *testing.T) {
+		pointer := ToIntSliceNonZero([]int{int(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("int/map", func(t *testing.T) {
+		pointer := ToIntMapNonZero(map[string]int{"foo": int(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("int/map/int64", func(t *testing.T) {
+		pointer := ToIntMapNonZeroOf(map[int64]int{42: int(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseInt(t *testing.T) {
+	pointer, err := ParseInt(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Int8(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		value := int8(42)
+
+		pointer := Int8(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("int8/slice", func(t *testing.T) {
+		value := []int8{int8(42), int8(69), int8(99)}
+
+		pointer := Int8Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int8/map", func(t *testing.T) {
+		value := map[string]int8{
+			"foo": int8(42),
+			"bar": int8(69),
+			"baz": int8(99),
+		}
+
+		pointer := Int8Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int8/map/int64", func(t *testing.T) {
+		value := map[int64]int8{
+			42: int8(42),
+			69: int8(69),
+		}
+
+		pointer := Int8MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Int8Value(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		pointer := int8(42)
+
+		value := Int8Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("int8/slice", func(t *testing.T) {
+		p1 := int8(42)
+		p2 := int8(69)
+		p3 := int8(99)
+		pointer := []*int8{&p1, &p2, &p3}
+
+		value := Int8ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int8/map", func(t *testing.T) {
+		p1 := int8(42)
+		p2 := int8(69)
+		p3 := int8(99)
+		pointer := map[string]*int8{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Int8ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int8/map/int64", func(t *testing.T) {
+		p1 := int8(42)
+		p2 := int8(69)
+		pointer := map[int64]*int8{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Int8ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Int8ValueOr(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		pointer := int8(42)
+
+		assert.Equal(t, pointer, Int8ValueOr(&pointer, int8(69)))
+		assert.Equal(t, int8(69), Int8ValueOr(nil, int8(69)))
+	})
+
+	t.Run("int8/slice", func(t *testing.T) {
+		p1 := int8(42)
+		pointer := []*int8{&p1, nil}
+
+		value := Int8ValueSliceOr(pointer, int8(69))
+		assert.Equal(t, []int8{int8(42), int8(69)}, value)
+	})
+
+	t.Run("int8/map", func(t *testing.T) {
+		p1 := int8(42)
+		pointer := map[string]*int8{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Int8ValueMapOr(pointer, int8(69))
+		assert.Equal(t, map[string]int8{"foo": int8(42), "bar": int8(69)}, value)
+	})
+
+	t.Run("int8/map/int64", func(t *testing.T) {
+		p1 := int8(42)
+		pointer := map[int64]*int8{42: &p1, 69: nil}
+
+		value := Int8ValueMapOrOf(pointer, int8(69))
+		assert.Equal(t, map[int64]int8{42: int8(42), 69: int8(69)}, value)
+	})
+}
+
+func Test_Int8ValueWith(t *testing.T) {
+	t.Run("int8/slice", func(t *testing.T) {
+		p1 := int8(42)
+		pointer := []*int8{&p1, nil}
+
+		value, err := Int8ValueSliceWith(pointer, DefaultNil(int8(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []int8{int8(42), int8(69)}, value)
+	})
+
+	t.Run("int8/map", func(t *testing.T) {
+		p1 := int8(42)
+		pointer := map[string]*int8{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Int8ValueMapWith(pointer, SkipNil[int8]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int8{"foo": int8(42)}, value)
+	})
+
+	t.Run("int8/map/int64", func(t *testing.T) {
+		p1 := int8(42)
+		pointer := map[int64]*int8{42: &p1, 69: nil}
+
+		value, err := Int8ValueMapWithOf(pointer, SkipNil[int8]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int8{42: int8(42)}, value)
+	})
+}
+
+func Test_ToInt8NonZero(t *testing.T) {
+	var zero int8
+
+	t.Run("int8", func(t *testing.T) {
+		assert.Equal(t, int8(42), *ToInt8NonZero(int8(42)))
+		assert.Nil(t, ToInt8NonZero(zero))
+	})
+
+	t.Run("int8/slice", func(t *testing.T) {
+		pointer := ToInt8SliceNonZero([]int8{int8(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int8(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("int8/map", func(t *testing.T) {
+		pointer := ToInt8MapNonZero(map[string]int8{"foo": int8(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int8(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("int8/map/int64", func(t *testing.T) {
+		pointer := ToInt8MapNonZeroOf(map[int64]int8{42: int8(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int8(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseInt8(t *testing.T) {
+	pointer, err := ParseInt8(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Int16(t *testing.T) {
+	t.Run("int16", func(t *testing.T) {
+		value := int16(42)
+
+		pointer := Int16(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("int16/slice", func(t *testing.T) {
+		value := []int16{int16(42), int16(69), int16(99)}
+
+		pointer := Int16Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int16/map", func(t *testing.T) {
+		value := map[string]int16{
+			"foo": int16(42),
+			"bar": int16(69),
+			"baz": int16(99),
+		}
+
+		pointer := Int16Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int16/map/int64", func(t *testing.T) {
+		value := map[int64]int16{
+			42: int16(42),
+			69: int16(69),
+		}
+
+		pointer := Int16MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Int16Value(t *testing.T) {
+	t.Run("int16", func(t *testing.T) {
+		pointer := int16(42)
+
+		value := Int16Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("int16/slice", func(t *testing.T) {
+		p1 := int16(42)
+		p2 := int16(69)
+		p3 := int16(99)
+		pointer := []*int16{&p1, &p2, &p3}
+
+		value := Int16ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int16/map", func(t *testing.T) {
+		p1 := int16(42)
+		p2 := int16(69)
+		p3 := int16(99)
+		pointer := map[string]*int16{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Int16ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int16/map/int64", func(t *testing.T) {
+		p1 := int16(42)
+		p2 := int16(69)
+		pointer := map[int64]*int16{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Int16ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Int16ValueOr(t *testing.T) {
+	t.Run("int16", func(t *testing.T) {
+		pointer := int16(42)
+
+		assert.Equal(t, pointer, Int16ValueOr(&pointer, int16(69)))
+		assert.Equal(t, int16(69), Int16ValueOr(nil, int16(69)))
+	})
+
+	t.Run("int16/slice", func(t *testing.T) {
+		p1 := int16(42)
+		pointer := []*int16{&p1, nil}
+
+		value := Int16ValueSliceOr(pointer, int16(69))
+		assert.Equal(t, []int16{int16(42), int16(69)}, value)
+	})
+
+	t.Run("int16/map", func(t *testing.T) {
+		p1 := int16(42)
+		pointer := map[string]*int16{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Int16ValueMapOr(pointer, int16(69))
+		assert.Equal(t, map[string]int16{"foo": int16(42), "bar": int16(69)}, value)
+	})
+
+	t.Run("int16/map/int64", func(t *testing.T) {
+		p1 := int16(42)
+		pointer := map[int64]*int16{42: &p1, 69: nil}
+
+		value := Int16ValueMapOrOf(pointer, int16(69))
+		assert.Equal(t, map[int64]int16{42: int16(42), 69: int16(69)}, value)
+	})
+}
+
+func Test_Int16ValueWith(t *testing.T) {
+	t.Run("int16/slice", func(t *testing.T) {
+		p1 := int16(42)
+		pointer := []*int16{&p1, nil}
+
+		value, err := Int16ValueSliceWith(pointer, DefaultNil(int16(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []int16{int16(42), int16(69)}, value)
+	})
+
+	t.Run("int16/map", func(t *testing.T) {
+		p1 := int16(42)
+		pointer := map[string]*int16{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Int16ValueMapWith(pointer, SkipNil[int16]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int16{"foo": int16(42)}, value)
+	})
+
+	t.Run("int16/map/int64", func(t *testing.T) {
+		p1 := int16(42)
+		pointer := map[int64]*int16{42: &p1, 69: nil}
+
+		value, err := Int16ValueMapWithOf(pointer, SkipNil[int16]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int16{42: int16(42)}, value)
+	})
+}
+
+func Test_ToInt16NonZero(t *testing.T) {
+	var zero int16
+
+	t.Run("int16", func(t *testing.T) {
+		assert.Equal(t, int16(42), *ToInt16NonZero(int16(42)))
+		assert.Nil(t, ToInt16NonZero(zero))
+	})
+
+	t.Run("int16/slice", func(t *testing.T) {
+		pointer := ToInt16SliceNonZero([]int16{int16(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int16(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("int16/map", func(t *testing.T) {
+		pointer := ToInt16MapNonZero(map[string]int16{"foo": int16(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int16(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("int16/map/int64", func(t *testing.T) {
+		pointer := ToInt16MapNonZeroOf(map[int64]int16{42: int16(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int16(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseInt16(t *testing.T) {
+	pointer, err := ParseInt16(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Int32(t *testing.T) {
+	t.Run("int32", func(t *testing.T) {
+		value := int32(42)
+
+		pointer := Int32(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("int32/slice", func(t *testing.T) {
+		value := []int32{int32(42), int32(69), int32(99)}
+
+		pointer := Int32Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int32/map", func(t *testing.T) {
+		value := map[string]int32{
+			"foo": int32(42),
+			"bar": int32(69),
+			"baz": int32(99),
+		}
+
+		pointer := Int32Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int32/map/int64", func(t *testing.T) {
+		value := map[int64]int32{
+			42: int32(42),
+			69: int32(69),
+		}
+
+		pointer := Int32MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Int32Value(t *testing.T) {
+	t.Run("int32", func(t *testing.T) {
+		pointer := int32(42)
+
+		value := Int32Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("int32/slice", func(t *testing.T) {
+		p1 := int32(42)
+		p2 := int32(69)
+		p3 := int32(99)
+		pointer := []*int32{&p1, &p2, &p3}
+
+		value := Int32ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int32/map", func(t *testing.T) {
+		p1 := int32(42)
+		p2 := int32(69)
+		p3 := int32(99)
+		pointer := map[string]*int32{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Int32ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int32/map/int64", func(t *testing.T) {
+		p1 := int32(42)
+		p2 := int32(69)
+		pointer := map[int64]*int32{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Int32ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Int32ValueOr(t *testing.T) {
+	t.Run("int32", func(t *testing.T) {
+		pointer := int32(42)
+
+		assert.Equal(t, pointer, Int32ValueOr(&pointer, int32(69)))
+		assert.Equal(t, int32(69), Int32ValueOr(nil, int32(69)))
+	})
+
+	t.Run("int32/slice", func(t *testing.T) {
+		p1 := int32(42)
+		pointer := []*int32{&p1, nil}
+
+		value := Int32ValueSliceOr(pointer, int32(69))
+		assert.Equal(t, []int32{int32(42), int32(69)}, value)
+	})
+
+	t.Run("int32/map", func(t *testing.T) {
+		p1 := int32(42)
+		pointer := map[string]*int32{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Int32ValueMapOr(pointer, int32(69))
+		assert.Equal(t, map[string]int32{"foo": int32(42), "bar": int32(69)}, value)
+	})
+
+	t.Run("int32/map/int64", func(t *testing.T) {
+		p1 := int32(42)
+		pointer := map[int64]*int32{42: &p1, 69: nil}
+
+		value := Int32ValueMapOrOf(pointer, int32(69))
+		assert.Equal(t, map[int64]int32{42: int32(42), 69: int32(69)}, value)
+	})
+}
+
+func Test_Int32ValueWith(t *testing.T) {
+	t.Run("int32/slice", func(t *testing.T) {
+		p1 := int32(42)
+		pointer := []*int32{&p1, nil}
+
+		value, err := Int32ValueSliceWith(pointer, DefaultNil(int32(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []int32{int32(42), int32(69)}, value)
+	})
+
+	t.Run("int32/map", func(t *testing.T) {
+		p1 := int32(42)
+		pointer := map[string]*int32{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Int32ValueMapWith(pointer, SkipNil[int32]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int32{"foo": int32(42)}, value)
+	})
+
+	t.Run("int32/map/int64", func(t *testing.T) {
+		p1 := int32(42)
+		pointer := map[int64]*int32{42: &p1, 69: nil}
+
+		value, err := Int32ValueMapWithOf(pointer, SkipNil[int32]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int32{42: int32(42)}, value)
+	})
+}
+
+func Test_ToInt32NonZero(t *testing.T) {
+	var zero int32
+
+	t.Run("int32", func(t *testing.T) {
+		assert.Equal(t, int32(42), *ToInt32NonZero(int32(42)))
+		assert.Nil(t, ToInt32NonZero(zero))
+	})
+
+	t.Run("int32/slice", func(t *testing.T) {
+		pointer := ToInt32SliceNonZero([]int32{int32(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int32(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("int32/map", func(t *testing.T) {
+		pointer := ToInt32MapNonZero(map[string]int32{"foo": int32(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int32(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("int32/map/int64", func(t *testing.T) {
+		pointer := ToInt32MapNonZeroOf(map[int64]int32{42: int32(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int32(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseInt32(t *testing.T) {
+	pointer, err := ParseInt32(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Int64(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		value := int64(42)
+
+		pointer := Int64(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("int64/slice", func(t *testing.T) {
+		value := []int64{int64(42), int64(69), int64(99)}
+
+		pointer := Int64Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int64/map", func(t *testing.T) {
+		value := map[string]int64{
+			"foo": int64(42),
+			"bar": int64(69),
+			"baz": int64(99),
+		}
+
+		pointer := Int64Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("int64/map/int64", func(t *testing.T) {
+		value := map[int64]int64{
+			42: int64(42),
+			69: int64(69),
+		}
+
+		pointer := Int64MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Int64Value(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		pointer := int64(42)
+
+		value := Int64Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("int64/slice", func(t *testing.T) {
+		p1 := int64(42)
+		p2 := int64(69)
+		p3 := int64(99)
+		pointer := []*int64{&p1, &p2, &p3}
+
+		value := Int64ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int64/map", func(t *testing.T) {
+		p1 := int64(42)
+		p2 := int64(69)
+		p3 := int64(99)
+		pointer := map[string]*int64{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Int64ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("int64/map/int64", func(t *testing.T) {
+		p1 := int64(42)
+		p2 := int64(69)
+		pointer := map[int64]*int64{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Int64ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Int64ValueOr(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		pointer := int64(42)
+
+		assert.Equal(t, pointer, Int64ValueOr(&pointer, int64(69)))
+		assert.Equal(t, int64(69), Int64ValueOr(nil, int64(69)))
+	})
+
+	t.Run("int64/slice", func(t *testing.T) {
+		p1 := int64(42)
+		pointer := []*int64{&p1, nil}
+
+		value := Int64ValueSliceOr(pointer, int64(69))
+		assert.Equal(t, []int64{int64(42), int64(69)}, value)
+	})
+
+	t.Run("int64/map", func(t *testing.T) {
+		p1 := int64(42)
+		pointer := map[string]*int64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Int64ValueMapOr(pointer, int64(69))
+		assert.Equal(t, map[string]int64{"foo": int64(42), "bar": int64(69)}, value)
+	})
+
+	t.Run("int64/map/int64", func(t *testing.T) {
+		p1 := int64(42)
+		pointer := map[int64]*int64{42: &p1, 69: nil}
+
+		value := Int64ValueMapOrOf(pointer, int64(69))
+		assert.Equal(t, map[int64]int64{42: int64(42), 69: int64(69)}, value)
+	})
+}
+
+func Test_Int64ValueWith(t *testing.T) {
+	t.Run("int64/slice", func(t *testing.T) {
+		p1 := int64(42)
+		pointer := []*int64{&p1, nil}
+
+		value, err := Int64ValueSliceWith(pointer, DefaultNil(int64(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{int64(42), int64(69)}, value)
+	})
+
+	t.Run("int64/map", func(t *testing.T) {
+		p1 := int64(42)
+		pointer := map[string]*int64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Int64ValueMapWith(pointer, SkipNil[int64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"foo": int64(42)}, value)
+	})
+
+	t.Run("int64/map/int64", func(t *testing.T) {
+		p1 := int64(42)
+		pointer := map[int64]*int64{42: &p1, 69: nil}
+
+		value, err := Int64ValueMapWithOf(pointer, SkipNil[int64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]int64{42: int64(42)}, value)
+	})
+}
+
+func Test_ToInt64NonZero(t *testing.T) {
+	var zero int64
+
+	t.Run("int64", func(t *testing.T) {
+		assert.Equal(t, int64(42), *ToInt64NonZero(int64(42)))
+		assert.Nil(t, ToInt64NonZero(zero))
+	})
+
+	t.Run("int64/slice", func(t *testing.T) {
+		pointer := ToInt64SliceNonZero([]int64{int64(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int64(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("int64/map", func(t *testing.T) {
+		pointer := ToInt64MapNonZero(map[string]int64{"foo": int64(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int64(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("int64/map/int64", func(t *testing.T) {
+		pointer := ToInt64MapNonZeroOf(map[int64]int64{42: int64(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, int64(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseInt64(t *testing.T) {
+	pointer, err := ParseInt64(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Uint(t *testing.T) {
+	t.Run("uint", func(t *testing.T) {
+		value := uint(42)
+
+		pointer := Uint(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("uint/slice", func(t *testing.T) {
+		value := []uint{uint(42), uint(69), uint(99)}
+
+		pointer := UintSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint/map", func(t *testing.T) {
+		value := map[string]uint{
+			"foo": uint(42),
+			"bar": uint(69),
+			"baz": uint(99),
+		}
+
+		pointer := UintMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint/map/int64", func(t *testing.T) {
+		value := map[int64]uint{
+			42: uint(42),
+			69: uint(69),
+		}
+
+		pointer := UintMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_UintValue(t *testing.T) {
+	t.Run("uint", func(t *testing.T) {
+		pointer := uint(42)
+
+		value := UintValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("uint/slice", func(t *testing.T) {
+		p1 := uint(42)
+		p2 := uint(69)
+		p3 := uint(99)
+		pointer := []*uint{&p1, &p2, &p3}
+
+		value := UintValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint/map", func(t *testing.T) {
+		p1 := uint(42)
+		p2 := uint(69)
+		p3 := uint(99)
+		pointer := map[string]*uint{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := UintValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint/map/int64", func(t *testing.T) {
+		p1 := uint(42)
+		p2 := uint(69)
+		pointer := map[int64]*uint{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := UintValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_UintValueOr(t *testing.T) {
+	t.Run("uint", func(t *testing.T) {
+		pointer := uint(42)
+
+		assert.Equal(t, pointer, UintValueOr(&pointer, uint(69)))
+		assert.Equal(t, uint(69), UintValueOr(nil, uint(69)))
+	})
+
+	t.Run("uint/slice", func(t *testing.T) {
+		p1 := uint(42)
+		pointer := []*uint{&p1, nil}
+
+		value := UintValueSliceOr(pointer, uint(69))
+		assert.Equal(t, []uint{uint(42), uint(69)}, value)
+	})
+
+	t.Run("uint/map", func(t *testing.T) {
+		p1 := uint(42)
+		pointer := map[string]*uint{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := UintValueMapOr(pointer, uint(69))
+		assert.Equal(t, map[string]uint{"foo": uint(42), "bar": uint(69)}, value)
+	})
+
+	t.Run("uint/map/int64", func(t *testing.T) {
+		p1 := uint(42)
+		pointer := map[int64]*uint{42: &p1, 69: nil}
+
+		value := UintValueMapOrOf(pointer, uint(69))
+		assert.Equal(t, map[int64]uint{42: uint(42), 69: uint(69)}, value)
+	})
+}
+
+func Test_UintValueWith(t *testing.T) {
+	t.Run("uint/slice", func(t *testing.T) {
+		p1 := uint(42)
+		pointer := []*uint{&p1, nil}
+
+		value, err := UintValueSliceWith(pointer, DefaultNil(uint(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []uint{uint(42), uint(69)}, value)
+	})
+
+	t.Run("uint/map", func(t *testing.T) {
+		p1 := uint(42)
+		pointer := map[string]*uint{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := UintValueMapWith(pointer, SkipNil[uint]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint{"foo": uint(42)}, value)
+	})
+
+	t.Run("uint/map/int64", func(t *testing.T) {
+		p1 := uint(42)
+		pointer := map[int64]*uint{42: &p1, 69: nil}
+
+		value, err := UintValueMapWithOf(pointer, SkipNil[uint]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]uint{42: uint(42)}, value)
+	})
+}
+
+func Test_ToUintNonZero(t *testing.T) {
+	var zero uint
+
+	t.Run("uint", func(t *testing.T) {
+		assert.Equal(t, uint(42), *ToUintNonZero(uint(42)))
+		assert.Nil(t, ToUintNonZero(zero))
+	})
+
+	t.Run("uint/slice", func(t *testing.T) {
+		pointer := ToUintSliceNonZero([]uint{uint(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("uint/map", func(t *testing.T) {
+		pointer := ToUintMapNonZero(map[string]uint{"foo": uint(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("uint/map/int64", func(t *testing.T) {
+		pointer := ToUintMapNonZeroOf(map[int64]uint{42: uint(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseUint(t *testing.T) {
+	pointer, err := ParseUint(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Uint8(t *testing.T) {
+	t.Run("uint8", func(t *testing.T) {
+		value := uint8(42)
+
+		pointer := Uint8(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("uint8/slice", func(t *testing.T) {
+		value := []uint8{uint8(42), uint8(69), uint8(99)}
+
+		pointer := Uint8Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint8/map", func(t *testing.T) {
+		value := map[string]uint8{
+			"foo": uint8(42),
+			"bar": uint8(69),
+			"baz": uint8(99),
+		}
+
+		pointer := Uint8Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint8/map/int64", func(t *testing.T) {
+		value := map[int64]uint8{
+			42: uint8(42),
+			69: uint8(69),
+		}
+
+		pointer := Uint8MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Uint8Value(t *testing.T) {
+	t.Run("uint8", func(t *testing.T) {
+		pointer := uint8(42)
+
+		value := Uint8Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("uint8/slice", func(t *testing.T) {
+		p1 := uint8(42)
+		p2 := uint8(69)
+		p3 := uint8(99)
+		pointer := []*uint8{&p1, &p2, &p3}
+
+		value := Uint8ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint8/map", func(t *testing.T) {
+		p1 := uint8(42)
+		p2 := uint8(69)
+		p3 := uint8(99)
+		pointer := map[string]*uint8{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Uint8ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint8/map/int64", func(t *testing.T) {
+		p1 := uint8(42)
+		p2 := uint8(69)
+		pointer := map[int64]*uint8{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Uint8ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Uint8ValueOr(t *testing.T) {
+	t.Run("uint8", func(t *testing.T) {
+		pointer := uint8(42)
+
+		assert.Equal(t, pointer, Uint8ValueOr(&pointer, uint8(69)))
+		assert.Equal(t, uint8(69), Uint8ValueOr(nil, uint8(69)))
+	})
+
+	t.Run("uint8/slice", func(t *testing.T) {
+		p1 := uint8(42)
+		pointer := []*uint8{&p1, nil}
+
+		value := Uint8ValueSliceOr(pointer, uint8(69))
+		assert.Equal(t, []uint8{uint8(42), uint8(69)}, value)
+	})
+
+	t.Run("uint8/map", func(t *testing.T) {
+		p1 := uint8(42)
+		pointer := map[string]*uint8{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Uint8ValueMapOr(pointer, uint8(69))
+		assert.Equal(t, map[string]uint8{"foo": uint8(42), "bar": uint8(69)}, value)
+	})
+
+	t.Run("uint8/map/int64", func(t *testing.T) {
+		p1 := uint8(42)
+		pointer := map[int64]*uint8{42: &p1, 69: nil}
+
+		value := Uint8ValueMapOrOf(pointer, uint8(69))
+		assert.Equal(t, map[int64]uint8{42: uint8(42), 69: uint8(69)}, value)
+	})
+}
+
+func Test_Uint8ValueWith(t *testing.T) {
+	t.Run("uint8/slice", func(t *testing.T) {
+		p1 := uint8(42)
+		pointer := []*uint8{&p1, nil}
+
+		value, err := Uint8ValueSliceWith(pointer, DefaultNil(uint8(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []uint8{uint8(42), uint8(69)}, value)
+	})
+
+	t.Run("uint8/map", func(t *testing.T) {
+		p1 := uint8(42)
+		pointer := map[string]*uint8{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Uint8ValueMapWith(pointer, SkipNil[uint8]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint8{"foo": uint8(42)}, value)
+	})
+
+	t.Run("uint8/map/int64", func(t *testing.T) {
+		p1 := uint8(42)
+		pointer := map[int64]*uint8{42: &p1, 69: nil}
+
+		value, err := Uint8ValueMapWithOf(pointer, SkipNil[uint8]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]uint8{42: uint8(42)}, value)
+	})
+}
+
+func Test_ToUint8NonZero(t *testing.T) {
+	var zero uint8
+
+	t.Run("uint8", func(t *testing.T) {
+		assert.Equal(t, uint8(42), *ToUint8NonZero(uint8(42)))
+		assert.Nil(t, ToUint8NonZero(zero))
+	})
+
+	t.Run("uint8/slice", func(t *testing.T) {
+		pointer := ToUint8SliceNonZero([]uint8{uint8(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint8(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("uint8/map", func(t *testing.T) {
+		pointer := ToUint8MapNonZero(map[string]uint8{"foo": uint8(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint8(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("uint8/map/int64", func(t *testing.T) {
+		pointer := ToUint8MapNonZeroOf(map[int64]uint8{42: uint8(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint8(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseUint8(t *testing.T) {
+	pointer, err := ParseUint8(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Uint16(t *testing.T) {
+	t.Run("uint16", func(t *testing.T) {
+		value := uint16(42)
+
+		pointer := Uint16(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("uint16/slice", func(t *testing.T) {
+		value := []uint16{uint16(42), uint16(69), uint16(99)}
+
+		pointer := Uint16Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint16/map", func(t *testing.T) {
+		value := map[string]uint16{
+			"foo": uint16(42),
+			"bar": uint16(69),
+			"baz": uint16(99),
+		}
+
+		pointer := Uint16Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint16/map/int64", func(t *testing.T) {
+		value := map[int64]uint16{
+			42: uint16(42),
+			69: uint16(69),
+		}
+
+		pointer := Uint16MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Uint16Value(t *testing.T) {
+	t.Run("uint16", func(t *testing.T) {
+		pointer := uint16(42)
+
+		value := Uint16Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("uint16/slice", func(t *testing.T) {
+		p1 := uint16(42)
+		p2 := uint16(69)
+		p3 := uint16(99)
+		pointer := []*uint16{&p1, &p2, &p3}
+
+		value := Uint16ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint16/map", func(t *testing.T) {
+		p1 := uint16(42)
+		p2 := uint16(69)
+		p3 := uint16(99)
+		pointer := map[string]*uint16{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Uint16ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint16/map/int64", func(t *testing.T) {
+		p1 := uint16(42)
+		p2 := uint16(69)
+		pointer := map[int64]*uint16{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Uint16ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Uint16ValueOr(t *testing.T) {
+	t.Run("uint16", func(t *testing.T) {
+		pointer := uint16(42)
+
+		assert.Equal(t, pointer, Uint16ValueOr(&pointer, uint16(69)))
+		assert.Equal(t, uint16(69), Uint16ValueOr(nil, uint16(69)))
+	})
+
+	t.Run("uint16/slice", func(t *testing.T) {
+		p1 := uint16(42)
+		pointer := []*uint16{&p1, nil}
+
+		value := Uint16ValueSliceOr(pointer, uint16(69))
+		assert.Equal(t, []uint16{uint16(42), uint16(69)}, value)
+	})
+
+	t.Run("uint16/map", func(t *testing.T) {
+		p1 := uint16(42)
+		pointer := map[string]*uint16{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Uint16ValueMapOr(pointer, uint16(69))
+		assert.Equal(t, map[string]uint16{"foo": uint16(42), "bar": uint16(69)}, value)
+	})
+
+	t.Run("uint16/map/int64", func(t *testing.T) {
+		p1 := uint16(42)
+		pointer := map[int64]*uint16{42: &p1, 69: nil}
+
+		value := Uint16ValueMapOrOf(pointer, uint16(69))
+		assert.Equal(t, map[int64]uint16{42: uint16(42), 69: uint16(69)}, value)
+	})
+}
+
+func Test_Uint16ValueWith(t *testing.T) {
+	t.Run("uint16/slice", func(t *testing.T) {
+		p1 := uint16(42)
+		pointer := []*uint16{&p1, nil}
+
+		value, err := Uint16ValueSliceWith(pointer, DefaultNil(uint16(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []uint16{uint16(42), uint16(69)}, value)
+	})
+
+	t.Run("uint16/map", func(t *testing.T) {
+		p1 := uint16(42)
+		pointer := map[string]*uint16{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Uint16ValueMapWith(pointer, SkipNil[uint16]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint16{"foo": uint16(42)}, value)
+	})
+
+	t.Run("uint16/map/int64", func(t *testing.T) {
+		p1 := uint16(42)
+		pointer := map[int64]*uint16{42: &p1, 69: nil}
+
+		value, err := Uint16ValueMapWithOf(pointer, SkipNil[uint16]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]uint16{42: uint16(42)}, value)
+	})
+}
+
+func Test_ToUint16NonZero(t *testing.T) {
+	var zero uint16
+
+	t.Run("uint16", func(t *testing.T) {
+		assert.Equal(t, uint16(42), *ToUint16NonZero(uint16(42)))
+		assert.Nil(t, ToUint16NonZero(zero))
+	})
+
+	t.Run("uint16/slice", func(t *testing.T) {
+		pointer := ToUint16SliceNonZero([]uint16{uint16(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint16(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("uint16/map", func(t *testing.T) {
+		pointer := ToUint16MapNonZero(map[string]uint16{"foo": uint16(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint16(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("uint16/map/int64", func(t *testing.T) {
+		pointer := ToUint16MapNonZeroOf(map[int64]uint16{42: uint16(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint16(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseUint16(t *testing.T) {
+	pointer, err := ParseUint16(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Uint32(t *testing.T) {
+	t.Run("uint32", func(t *testing.T) {
+		value := uint32(42)
+
+		pointer := Uint32(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("uint32/slice", func(t *testing.T) {
+		value := []uint32{uint32(42), uint32(69), uint32(99)}
+
+		pointer := Uint32Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint32/map", func(t *testing.T) {
+		value := map[string]uint32{
+			"foo": uint32(42),
+			"bar": uint32(69),
+			"baz": uint32(99),
+		}
+
+		pointer := Uint32Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint32/map/int64", func(t *testing.T) {
+		value := map[int64]uint32{
+			42: uint32(42),
+			69: uint32(69),
+		}
+
+		pointer := Uint32MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Uint32Value(t *testing.T) {
+	t.Run("uint32", func(t *testing.T) {
+		pointer := uint32(42)
+
+		value := Uint32Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("uint32/slice", func(t *testing.T) {
+		p1 := uint32(42)
+		p2 := uint32(69)
+		p3 := uint32(99)
+		pointer := []*uint32{&p1, &p2, &p3}
+
+		value := Uint32ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint32/map", func(t *testing.T) {
+		p1 := uint32(42)
+		p2 := uint32(69)
+		p3 := uint32(99)
+		pointer := map[string]*uint32{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Uint32ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint32/map/int64", func(t *testing.T) {
+		p1 := uint32(42)
+		p2 := uint32(69)
+		pointer := map[int64]*uint32{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Uint32ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Uint32ValueOr(t *testing.T) {
+	t.Run("uint32", func(t *testing.T) {
+		pointer := uint32(42)
+
+		assert.Equal(t, pointer, Uint32ValueOr(&pointer, uint32(69)))
+		assert.Equal(t, uint32(69), Uint32ValueOr(nil, uint32(69)))
+	})
+
+	t.Run("uint32/slice", func(t *testing.T) {
+		p1 := uint32(42)
+		pointer := []*uint32{&p1, nil}
+
+		value := Uint32ValueSliceOr(pointer, uint32(69))
+		assert.Equal(t, []uint32{uint32(42), uint32(69)}, value)
+	})
+
+	t.Run("uint32/map", func(t *testing.T) {
+		p1 := uint32(42)
+		pointer := map[string]*uint32{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Uint32ValueMapOr(pointer, uint32(69))
+		assert.Equal(t, map[string]uint32{"foo": uint32(42), "bar": uint32(69)}, value)
+	})
+
+	t.Run("uint32/map/int64", func(t *testing.T) {
+		p1 := uint32(42)
+		pointer := map[int64]*uint32{42: &p1, 69: nil}
+
+		value := Uint32ValueMapOrOf(pointer, uint32(69))
+		assert.Equal(t, map[int64]uint32{42: uint32(42), 69: uint32(69)}, value)
+	})
+}
+
+func Test_Uint32ValueWith(t *testing.T) {
+	t.Run("uint32/slice", func(t *testing.T) {
+		p1 := uint32(42)
+		pointer := []*uint32{&p1, nil}
+
+		value, err := Uint32ValueSliceWith(pointer, DefaultNil(uint32(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{uint32(42), uint32(69)}, value)
+	})
+
+	t.Run("uint32/map", func(t *testing.T) {
+		p1 := uint32(42)
+		pointer := map[string]*uint32{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Uint32ValueMapWith(pointer, SkipNil[uint32]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint32{"foo": uint32(42)}, value)
+	})
+
+	t.Run("uint32/map/int64", func(t *testing.T) {
+		p1 := uint32(42)
+		pointer := map[int64]*uint32{42: &p1, 69: nil}
+
+		value, err := Uint32ValueMapWithOf(pointer, SkipNil[uint32]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]uint32{42: uint32(42)}, value)
+	})
+}
+
+func Test_ToUint32NonZero(t *testing.T) {
+	var zero uint32
+
+	t.Run("uint32", func(t *testing.T) {
+		assert.Equal(t, uint32(42), *ToUint32NonZero(uint32(42)))
+		assert.Nil(t, ToUint32NonZero(zero))
+	})
+
+	t.Run("uint32/slice", func(t *testing.T) {
+		pointer := ToUint32SliceNonZero([]uint32{uint32(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint32(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("uint32/map", func(t *testing.T) {
+		pointer := ToUint32MapNonZero(map[string]uint32{"foo": uint32(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint32(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("uint32/map/int64", func(t *testing.T) {
+		pointer := ToUint32MapNonZeroOf(map[int64]uint32{42: uint32(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint32(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseUint32(t *testing.T) {
+	pointer, err := ParseUint32(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Uint64(t *testing.T) {
+	t.Run("uint64", func(t *testing.T) {
+		value := uint64(42)
+
+		pointer := Uint64(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("uint64/slice", func(t *testing.T) {
+		value := []uint64{uint64(42), uint64(69), uint64(99)}
+
+		pointer := Uint64Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint64/map", func(t *testing.T) {
+		value := map[string]uint64{
+			"foo": uint64(42),
+			"bar": uint64(69),
+			"baz": uint64(99),
+		}
+
+		pointer := Uint64Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uint64/map/int64", func(t *testing.T) {
+		value := map[int64]uint64{
+			42: uint64(42),
+			69: uint64(69),
+		}
+
+		pointer := Uint64MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Uint64Value(t *testing.T) {
+	t.Run("uint64", func(t *testing.T) {
+		pointer := uint64(42)
+
+		value := Uint64Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("uint64/slice", func(t *testing.T) {
+		p1 := uint64(42)
+		p2 := uint64(69)
+		p3 := uint64(99)
+		pointer := []*uint64{&p1, &p2, &p3}
+
+		value := Uint64ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint64/map", func(t *testing.T) {
+		p1 := uint64(42)
+		p2 := uint64(69)
+		p3 := uint64(99)
+		pointer := map[string]*uint64{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Uint64ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uint64/map/int64", func(t *testing.T) {
+		p1 := uint64(42)
+		p2 := uint64(69)
+		pointer := map[int64]*uint64{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Uint64ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Uint64ValueOr(t *testing.T) {
+	t.Run("uint64", func(t *testing.T) {
+		pointer := uint64(42)
+
+		assert.Equal(t, pointer, Uint64ValueOr(&pointer, uint64(69)))
+		assert.Equal(t, uint64(69), Uint64ValueOr(nil, uint64(69)))
+	})
+
+	t.Run("uint64/slice", func(t *testing.T) {
+		p1 := uint64(42)
+		pointer := []*uint64{&p1, nil}
+
+		value := Uint64ValueSliceOr(pointer, uint64(69))
+		assert.Equal(t, []uint64{uint64(42), uint64(69)}, value)
+	})
+
+	t.Run("uint64/map", func(t *testing.T) {
+		p1 := uint64(42)
+		pointer := map[string]*uint64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Uint64ValueMapOr(pointer, uint64(69))
+		assert.Equal(t, map[string]uint64{"foo": uint64(42), "bar": uint64(69)}, value)
+	})
+
+	t.Run("uint64/map/int64", func(t *testing.T) {
+		p1 := uint64(42)
+		pointer := map[int64]*uint64{42: &p1, 69: nil}
+
+		value := Uint64ValueMapOrOf(pointer, uint64(69))
+		assert.Equal(t, map[int64]uint64{42: uint64(42), 69: uint64(69)}, value)
+	})
+}
+
+func Test_Uint64ValueWith(t *testing.T) {
+	t.Run("uint64/slice", func(t *testing.T) {
+		p1 := uint64(42)
+		pointer := []*uint64{&p1, nil}
+
+		value, err := Uint64ValueSliceWith(pointer, DefaultNil(uint64(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{uint64(42), uint64(69)}, value)
+	})
+
+	t.Run("uint64/map", func(t *testing.T) {
+		p1 := uint64(42)
+		pointer := map[string]*uint64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Uint64ValueMapWith(pointer, SkipNil[uint64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uint64{"foo": uint64(42)}, value)
+	})
+
+	t.Run("uint64/map/int64", func(t *testing.T) {
+		p1 := uint64(42)
+		pointer := map[int64]*uint64{42: &p1, 69: nil}
+
+		value, err := Uint64ValueMapWithOf(pointer, SkipNil[uint64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]uint64{42: uint64(42)}, value)
+	})
+}
+
+func Test_ToUint64NonZero(t *testing.T) {
+	var zero uint64
+
+	t.Run("uint64", func(t *testing.T) {
+		assert.Equal(t, uint64(42), *ToUint64NonZero(uint64(42)))
+		assert.Nil(t, ToUint64NonZero(zero))
+	})
+
+	t.Run("uint64/slice", func(t *testing.T) {
+		pointer := ToUint64SliceNonZero([]uint64{uint64(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint64(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("uint64/map", func(t *testing.T) {
+		pointer := ToUint64MapNonZero(map[string]uint64{"foo": uint64(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint64(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("uint64/map/int64", func(t *testing.T) {
+		pointer := ToUint64MapNonZeroOf(map[int64]uint64{42: uint64(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uint64(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseUint64(t *testing.T) {
+	pointer, err := ParseUint64(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Uintptr(t *testing.T) {
+	t.Run("uintptr", func(t *testing.T) {
+		value := uintptr(42)
+
+		pointer := Uintptr(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("uintptr/slice", func(t *testing.T) {
+		value := []uintptr{uintptr(42), uintptr(69), uintptr(99)}
+
+		pointer := UintptrSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uintptr/map", func(t *testing.T) {
+		value := map[string]uintptr{
+			"foo": uintptr(42),
+			"bar": uintptr(69),
+			"baz": uintptr(99),
+		}
+
+		pointer := UintptrMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("uintptr/map/int64", func(t *testing.T) {
+		value := map[int64]uintptr{
+			42: uintptr(42),
+			69: uintptr(69),
+		}
+
+		pointer := UintptrMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_UintptrValue(t *testing.T) {
+	t.Run("uintptr", func(t *testing.T) {
+		pointer := uintptr(42)
+
+		value := UintptrValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("uintptr/slice", func(t *testing.T) {
+		p1 := uintptr(42)
+		p2 := uintptr(69)
+		p3 := uintptr(99)
+		pointer := []*uintptr{&p1, &p2, &p3}
+
+		value := UintptrValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uintptr/map", func(t *testing.T) {
+		p1 := uintptr(42)
+		p2 := uintptr(69)
+		p3 := uintptr(99)
+		pointer := map[string]*uintptr{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := UintptrValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("uintptr/map/int64", func(t *testing.T) {
+		p1 := uintptr(42)
+		p2 := uintptr(69)
+		pointer := map[int64]*uintptr{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := UintptrValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_UintptrValueOr(t *testing.T) {
+	t.Run("uintptr", func(t *testing.T) {
+		pointer := uintptr(42)
+
+		assert.Equal(t, pointer, UintptrValueOr(&pointer, uintptr(69)))
+		assert.Equal(t, uintptr(69), UintptrValueOr(nil, uintptr(69)))
+	})
+
+	t.Run("uintptr/slice", func(t *testing.T) {
+		p1 := uintptr(42)
+		pointer := []*uintptr{&p1, nil}
+
+		value := UintptrValueSliceOr(pointer, uintptr(69))
+		assert.Equal(t, []uintptr{uintptr(42), uintptr(69)}, value)
+	})
+
+	t.Run("uintptr/map", func(t *testing.T) {
+		p1 := uintptr(42)
+		pointer := map[string]*uintptr{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := UintptrValueMapOr(pointer, uintptr(69))
+		assert.Equal(t, map[string]uintptr{"foo": uintptr(42), "bar": uintptr(69)}, value)
+	})
+
+	t.Run("uintptr/map/int64", func(t *testing.T) {
+		p1 := uintptr(42)
+		pointer := map[int64]*uintptr{42: &p1, 69: nil}
+
+		value := UintptrValueMapOrOf(pointer, uintptr(69))
+		assert.Equal(t, map[int64]uintptr{42: uintptr(42), 69: uintptr(69)}, value)
+	})
+}
+
+func Test_UintptrValueWith(t *testing.T) {
+	t.Run("uintptr/slice", func(t *testing.T) {
+		p1 := uintptr(42)
+		pointer := []*uintptr{&p1, nil}
+
+		value, err := UintptrValueSliceWith(pointer, DefaultNil(uintptr(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []uintptr{uintptr(42), uintptr(69)}, value)
+	})
+
+	t.Run("uintptr/map", func(t *testing.T) {
+		p1 := uintptr(42)
+		pointer := map[string]*uintptr{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := UintptrValueMapWith(pointer, SkipNil[uintptr]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]uintptr{"foo": uintptr(42)}, value)
+	})
+
+	t.Run("uintptr/map/int64", func(t *testing.T) {
+		p1 := uintptr(42)
+		pointer := map[int64]*uintptr{42: &p1, 69: nil}
+
+		value, err := UintptrValueMapWithOf(pointer, SkipNil[uintptr]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]uintptr{42: uintptr(42)}, value)
+	})
+}
+
+func Test_ToUintptrNonZero(t *testing.T) {
+	var zero uintptr
+
+	t.Run("uintptr", func(t *testing.T) {
+		assert.Equal(t, uintptr(42), *ToUintptrNonZero(uintptr(42)))
+		assert.Nil(t, ToUintptrNonZero(zero))
+	})
+
+	t.Run("uintptr/slice", func(t *testing.T) {
+		pointer := ToUintptrSliceNonZero([]uintptr{uintptr(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uintptr(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("uintptr/map", func(t *testing.T) {
+		pointer := ToUintptrMapNonZero(map[string]uintptr{"foo": uintptr(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uintptr(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("uintptr/map/int64", func(t *testing.T) {
+		pointer := ToUintptrMapNonZeroOf(map[int64]uintptr{42: uintptr(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, uintptr(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseUintptr(t *testing.T) {
+	pointer, err := ParseUintptr(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Float32(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		value := float32(42)
+
+		pointer := Float32(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("float32/slice", func(t *testing.T) {
+		value := []float32{float32(42), float32(69), float32(99)}
+
+		pointer := Float32Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("float32/map", func(t *testing.T) {
+		value := map[string]float32{
+			"foo": float32(42),
+			"bar": float32(69),
+			"baz": float32(99),
+		}
+
+		pointer := Float32Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("float32/map/int64", func(t *testing.T) {
+		value := map[int64]float32{
+			42: float32(42),
+			69: float32(69),
+		}
+
+		pointer := Float32MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Float32Value(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		pointer := float32(42)
+
+		value := Float32Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("float32/slice", func(t *testing.T) {
+		p1 := float32(42)
+		p2 := float32(69)
+		p3 := float32(99)
+		pointer := []*float32{&p1, &p2, &p3}
+
+		value := Float32ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("float32/map", func(t *testing.T) {
+		p1 := float32(42)
+		p2 := float32(69)
+		p3 := float32(99)
+		pointer := map[string]*float32{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Float32ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("float32/map/int64", func(t *testing.T) {
+		p1 := float32(42)
+		p2 := float32(69)
+		pointer := map[int64]*float32{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Float32ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Float32ValueOr(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		pointer := float32(42)
+
+		assert.Equal(t, pointer, Float32ValueOr(&pointer, float32(69)))
+		assert.Equal(t, float32(69), Float32ValueOr(nil, float32(69)))
+	})
+
+	t.Run("float32/slice", func(t *testing.T) {
+		p1 := float32(42)
+		pointer := []*float32{&p1, nil}
+
+		value := Float32ValueSliceOr(pointer, float32(69))
+		assert.Equal(t, []float32{float32(42), float32(69)}, value)
+	})
+
+	t.Run("float32/map", func(t *testing.T) {
+		p1 := float32(42)
+		pointer := map[string]*float32{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Float32ValueMapOr(pointer, float32(69))
+		assert.Equal(t, map[string]float32{"foo": float32(42), "bar": float32(69)}, value)
+	})
+
+	t.Run("float32/map/int64", func(t *testing.T) {
+		p1 := float32(42)
+		pointer := map[int64]*float32{42: &p1, 69: nil}
+
+		value := Float32ValueMapOrOf(pointer, float32(69))
+		assert.Equal(t, map[int64]float32{42: float32(42), 69: float32(69)}, value)
+	})
+}
+
+func Test_Float32ValueWith(t *testing.T) {
+	t.Run("float32/slice", func(t *testing.T) {
+		p1 := float32(42)
+		pointer := []*float32{&p1, nil}
+
+		value, err := Float32ValueSliceWith(pointer, DefaultNil(float32(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []float32{float32(42), float32(69)}, value)
+	})
+
+	t.Run("float32/map", func(t *testing.T) {
+		p1 := float32(42)
+		pointer := map[string]*float32{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Float32ValueMapWith(pointer, SkipNil[float32]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float32{"foo": float32(42)}, value)
+	})
+
+	t.Run("float32/map/int64", func(t *testing.T) {
+		p1 := float32(42)
+		pointer := map[int64]*float32{42: &p1, 69: nil}
+
+		value, err := Float32ValueMapWithOf(pointer, SkipNil[float32]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]float32{42: float32(42)}, value)
+	})
+}
+
+func Test_ToFloat32NonZero(t *testing.T) {
+	var zero float32
+
+	t.Run("float32", func(t *testing.T) {
+		assert.Equal(t, float32(42), *ToFloat32NonZero(float32(42)))
+		assert.Nil(t, ToFloat32NonZero(zero))
+	})
+
+	t.Run("float32/slice", func(t *testing.T) {
+		pointer := ToFloat32SliceNonZero([]float32{float32(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, float32(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("float32/map", func(t *testing.T) {
+		pointer := ToFloat32MapNonZero(map[string]float32{"foo": float32(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, float32(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("float32/map/int64", func(t *testing.T) {
+		pointer := ToFloat32MapNonZeroOf(map[int64]float32{42: float32(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, float32(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseFloat32(t *testing.T) {
+	pointer, err := ParseFloat32(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Float64(t *testing.T) {
+	t.Run("float64", func(t *testing.T) {
+		value := float64(42)
+
+		pointer := Float64(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("float64/slice", func(t *testing.T) {
+		value := []float64{float64(42), float64(69), float64(99)}
+
+		pointer := Float64Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("float64/map", func(t *testing.T) {
+		value := map[string]float64{
+			"foo": float64(42),
+			"bar": float64(69),
+			"baz": float64(99),
+		}
+
+		pointer := Float64Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("float64/map/int64", func(t *testing.T) {
+		value := map[int64]float64{
+			42: float64(42),
+			69: float64(69),
+		}
+
+		pointer := Float64MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Float64Value(t *testing.T) {
+	t.Run("float64", func(t *testing.T) {
+		pointer := float64(42)
+
+		value := Float64Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("float64/slice", func(t *testing.T) {
+		p1 := float64(42)
+		p2 := float64(69)
+		p3 := float64(99)
+		pointer := []*float64{&p1, &p2, &p3}
+
+		value := Float64ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("float64/map", func(t *testing.T) {
+		p1 := float64(42)
+		p2 := float64(69)
+		p3 := float64(99)
+		pointer := map[string]*float64{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Float64ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("float64/map/int64", func(t *testing.T) {
+		p1 := float64(42)
+		p2 := float64(69)
+		pointer := map[int64]*float64{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Float64ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Float64ValueOr(t *testing.T) {
+	t.Run("float64", func(t *testing.T) {
+		pointer := float64(42)
+
+		assert.Equal(t, pointer, Float64ValueOr(&pointer, float64(69)))
+		assert.Equal(t, float64(69), Float64ValueOr(nil, float64(69)))
+	})
+
+	t.Run("float64/slice", func(t *testing.T) {
+		p1 := float64(42)
+		pointer := []*float64{&p1, nil}
+
+		value := Float64ValueSliceOr(pointer, float64(69))
+		assert.Equal(t, []float64{float64(42), float64(69)}, value)
+	})
+
+	t.Run("float64/map", func(t *testing.T) {
+		p1 := float64(42)
+		pointer := map[string]*float64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Float64ValueMapOr(pointer, float64(69))
+		assert.Equal(t, map[string]float64{"foo": float64(42), "bar": float64(69)}, value)
+	})
+
+	t.Run("float64/map/int64", func(t *testing.T) {
+		p1 := float64(42)
+		pointer := map[int64]*float64{42: &p1, 69: nil}
+
+		value := Float64ValueMapOrOf(pointer, float64(69))
+		assert.Equal(t, map[int64]float64{42: float64(42), 69: float64(69)}, value)
+	})
+}
+
+func Test_Float64ValueWith(t *testing.T) {
+	t.Run("float64/slice", func(t *testing.T) {
+		p1 := float64(42)
+		pointer := []*float64{&p1, nil}
+
+		value, err := Float64ValueSliceWith(pointer, DefaultNil(float64(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []float64{float64(42), float64(69)}, value)
+	})
+
+	t.Run("float64/map", func(t *testing.T) {
+		p1 := float64(42)
+		pointer := map[string]*float64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Float64ValueMapWith(pointer, SkipNil[float64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"foo": float64(42)}, value)
+	})
+
+	t.Run("float64/map/int64", func(t *testing.T) {
+		p1 := float64(42)
+		pointer := map[int64]*float64{42: &p1, 69: nil}
+
+		value, err := Float64ValueMapWithOf(pointer, SkipNil[float64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]float64{42: float64(42)}, value)
+	})
+}
+
+func Test_ToFloat64NonZero(t *testing.T) {
+	var zero float64
+
+	t.Run("float64", func(t *testing.T) {
+		assert.Equal(t, float64(42), *ToFloat64NonZero(float64(42)))
+		assert.Nil(t, ToFloat64NonZero(zero))
+	})
+
+	t.Run("float64/slice", func(t *testing.T) {
+		pointer := ToFloat64SliceNonZero([]float64{float64(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, float64(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("float64/map", func(t *testing.T) {
+		pointer := ToFloat64MapNonZero(map[string]float64{"foo": float64(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, float64(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("float64/map/int64", func(t *testing.T) {
+		pointer := ToFloat64MapNonZeroOf(map[int64]float64{42: float64(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, float64(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseFloat64(t *testing.T) {
+	pointer, err := ParseFloat64(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Complex64(t *testing.T) {
+	t.Run("complex64", func(t *testing.T) {
+		value := complex64(42)
+
+		pointer := Complex64(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("complex64/slice", func(t *testing.T) {
+		value := []complex64{complex64(42), complex64(69), complex64(99)}
+
+		pointer := Complex64Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("complex64/map", func(t *testing.T) {
+		value := map[string]complex64{
+			"foo": complex64(42),
+			"bar": complex64(69),
+			"baz": complex64(99),
+		}
+
+		pointer := Complex64Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("complex64/map/int64", func(t *testing.T) {
+		value := map[int64]complex64{
+			42: complex64(42),
+			69: complex64(69),
+		}
+
+		pointer := Complex64MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Complex64Value(t *testing.T) {
+	t.Run("complex64", func(t *testing.T) {
+		pointer := complex64(42)
+
+		value := Complex64Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("complex64/slice", func(t *testing.T) {
+		p1 := complex64(42)
+		p2 := complex64(69)
+		p3 := complex64(99)
+		pointer := []*complex64{&p1, &p2, &p3}
+
+		value := Complex64ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("complex64/map", func(t *testing.T) {
+		p1 := complex64(42)
+		p2 := complex64(69)
+		p3 := complex64(99)
+		pointer := map[string]*complex64{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Complex64ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("complex64/map/int64", func(t *testing.T) {
+		p1 := complex64(42)
+		p2 := complex64(69)
+		pointer := map[int64]*complex64{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Complex64ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Complex64ValueOr(t *testing.T) {
+	t.Run("complex64", func(t *testing.T) {
+		pointer := complex64(42)
+
+		assert.Equal(t, pointer, Complex64ValueOr(&pointer, complex64(69)))
+		assert.Equal(t, complex64(69), Complex64ValueOr(nil, complex64(69)))
+	})
+
+	t.Run("complex64/slice", func(t *testing.T) {
+		p1 := complex64(42)
+		pointer := []*complex64{&p1, nil}
+
+		value := Complex64ValueSliceOr(pointer, complex64(69))
+		assert.Equal(t, []complex64{complex64(42), complex64(69)}, value)
+	})
+
+	t.Run("complex64/map", func(t *testing.T) {
+		p1 := complex64(42)
+		pointer := map[string]*complex64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Complex64ValueMapOr(pointer, complex64(69))
+		assert.Equal(t, map[string]complex64{"foo": complex64(42), "bar": complex64(69)}, value)
+	})
+
+	t.Run("complex64/map/int64", func(t *testing.T) {
+		p1 := complex64(42)
+		pointer := map[int64]*complex64{42: &p1, 69: nil}
+
+		value := Complex64ValueMapOrOf(pointer, complex64(69))
+		assert.Equal(t, map[int64]complex64{42: complex64(42), 69: complex64(69)}, value)
+	})
+}
+
+func Test_Complex64ValueWith(t *testing.T) {
+	t.Run("complex64/slice", func(t *testing.T) {
+		p1 := complex64(42)
+		pointer := []*complex64{&p1, nil}
+
+		value, err := Complex64ValueSliceWith(pointer, DefaultNil(complex64(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []complex64{complex64(42), complex64(69)}, value)
+	})
+
+	t.Run("complex64/map", func(t *testing.T) {
+		p1 := complex64(42)
+		pointer := map[string]*complex64{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Complex64ValueMapWith(pointer, SkipNil[complex64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]complex64{"foo": complex64(42)}, value)
+	})
+
+	t.Run("complex64/map/int64", func(t *testing.T) {
+		p1 := complex64(42)
+		pointer := map[int64]*complex64{42: &p1, 69: nil}
+
+		value, err := Complex64ValueMapWithOf(pointer, SkipNil[complex64]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]complex64{42: complex64(42)}, value)
+	})
+}
+
+func Test_ToComplex64NonZero(t *testing.T) {
+	var zero complex64
+
+	t.Run("complex64", func(t *testing.T) {
+		assert.Equal(t, complex64(42), *ToComplex64NonZero(complex64(42)))
+		assert.Nil(t, ToComplex64NonZero(zero))
+	})
+
+	t.Run("complex64/slice", func(t *testing.T) {
+		pointer := ToComplex64SliceNonZero([]complex64{complex64(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, complex64(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("complex64/map", func(t *testing.T) {
+		pointer := ToComplex64MapNonZero(map[string]complex64{"foo": complex64(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, complex64(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("complex64/map/int64", func(t *testing.T) {
+		pointer := ToComplex64MapNonZeroOf(map[int64]complex64{42: complex64(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, complex64(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseComplex64(t *testing.T) {
+	pointer, err := ParseComplex64(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Complex128(t *testing.T) {
+	t.Run("complex128", func(t *testing.T) {
+		value := complex128(42)
+
+		pointer := Complex128(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("complex128/slice", func(t *testing.T) {
+		value := []complex128{complex128(42), complex128(69), complex128(99)}
+
+		pointer := Complex128Slice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("complex128/map", func(t *testing.T) {
+		value := map[string]complex128{
+			"foo": complex128(42),
+			"bar": complex128(69),
+			"baz": complex128(99),
+		}
+
+		pointer := Complex128Map(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("complex128/map/int64", func(t *testing.T) {
+		value := map[int64]complex128{
+			42: complex128(42),
+			69: complex128(69),
+		}
+
+		pointer := Complex128MapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_Complex128Value(t *testing.T) {
+	t.Run("complex128", func(t *testing.T) {
+		pointer := complex128(42)
+
+		value := Complex128Value(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("complex128/slice", func(t *testing.T) {
+		p1 := complex128(42)
+		p2 := complex128(69)
+		p3 := complex128(99)
+		pointer := []*complex128{&p1, &p2, &p3}
+
+		value := Complex128ValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("complex128/map", func(t *testing.T) {
+		p1 := complex128(42)
+		p2 := complex128(69)
+		p3 := complex128(99)
+		pointer := map[string]*complex128{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := Complex128ValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("complex128/map/int64", func(t *testing.T) {
+		p1 := complex128(42)
+		p2 := complex128(69)
+		pointer := map[int64]*complex128{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := Complex128ValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_Complex128ValueOr(t *testing.T) {
+	t.Run("complex128", func(t *testing.T) {
+		pointer := complex128(42)
+
+		assert.Equal(t, pointer, Complex128ValueOr(&pointer, complex128(69)))
+		assert.Equal(t, complex128(69), Complex128ValueOr(nil, complex128(69)))
+	})
+
+	t.Run("complex128/slice", func(t *testing.T) {
+		p1 := complex128(42)
+		pointer := []*complex128{&p1, nil}
+
+		value := Complex128ValueSliceOr(pointer, complex128(69))
+		assert.Equal(t, []complex128{complex128(42), complex128(69)}, value)
+	})
+
+	t.Run("complex128/map", func(t *testing.T) {
+		p1 := complex128(42)
+		pointer := map[string]*complex128{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := Complex128ValueMapOr(pointer, complex128(69))
+		assert.Equal(t, map[string]complex128{"foo": complex128(42), "bar": complex128(69)}, value)
+	})
+
+	t.Run("complex128/map/int64", func(t *testing.T) {
+		p1 := complex128(42)
+		pointer := map[int64]*complex128{42: &p1, 69: nil}
+
+		value := Complex128ValueMapOrOf(pointer, complex128(69))
+		assert.Equal(t, map[int64]complex128{42: complex128(42), 69: complex128(69)}, value)
+	})
+}
+
+func Test_Complex128ValueWith(t *testing.T) {
+	t.Run("complex128/slice", func(t *testing.T) {
+		p1 := complex128(42)
+		pointer := []*complex128{&p1, nil}
+
+		value, err := Complex128ValueSliceWith(pointer, DefaultNil(complex128(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []complex128{complex128(42), complex128(69)}, value)
+	})
+
+	t.Run("complex128/map", func(t *testing.T) {
+		p1 := complex128(42)
+		pointer := map[string]*complex128{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := Complex128ValueMapWith(pointer, SkipNil[complex128]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]complex128{"foo": complex128(42)}, value)
+	})
+
+	t.Run("complex128/map/int64", func(t *testing.T) {
+		p1 := complex128(42)
+		pointer := map[int64]*complex128{42: &p1, 69: nil}
+
+		value, err := Complex128ValueMapWithOf(pointer, SkipNil[complex128]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]complex128{42: complex128(42)}, value)
+	})
+}
+
+func Test_ToComplex128NonZero(t *testing.T) {
+	var zero complex128
+
+	t.Run("complex128", func(t *testing.T) {
+		assert.Equal(t, complex128(42), *ToComplex128NonZero(complex128(42)))
+		assert.Nil(t, ToComplex128NonZero(zero))
+	})
+
+	t.Run("complex128/slice", func(t *testing.T) {
+		pointer := ToComplex128SliceNonZero([]complex128{complex128(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, complex128(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("complex128/map", func(t *testing.T) {
+		pointer := ToComplex128MapNonZero(map[string]complex128{"foo": complex128(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, complex128(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("complex128/map/int64", func(t *testing.T) {
+		pointer := ToComplex128MapNonZeroOf(map[int64]complex128{42: complex128(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, complex128(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseComplex128(t *testing.T) {
+	pointer, err := ParseComplex128(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Time(t *testing.T) {
+	t.Run("time.Time", func(t *testing.T) {
+		value := time.Unix(42, 0)
+
+		pointer := Time(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("time.Time/slice", func(t *testing.T) {
+		value := []time.Time{time.Unix(42, 0), time.Unix(69, 0), time.Unix(99, 0)}
+
+		pointer := TimeSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Time/map", func(t *testing.T) {
+		value := map[string]time.Time{
+			"foo": time.Unix(42, 0),
+			"bar": time.Unix(69, 0),
+			"baz": time.Unix(99, 0),
+		}
+
+		pointer := TimeMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Time/map/int64", func(t *testing.T) {
+		value := map[int64]time.Time{
+			42: time.Unix(42, 0),
+			69: time.Unix(69, 0),
+		}
+
+		pointer := TimeMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_TimeValue(t *testing.T) {
+	t.Run("time.Time", func(t *testing.T) {
+		pointer := time.Unix(42, 0)
+
+		value := TimeValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("time.Time/slice", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		p2 := time.Unix(69, 0)
+		p3 := time.Unix(99, 0)
+		pointer := []*time.Time{&p1, &p2, &p3}
+
+		value := TimeValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Time/map", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		p2 := time.Unix(69, 0)
+		p3 := time.Unix(99, 0)
+		pointer := map[string]*time.Time{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := TimeValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Time/map/int64", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		p2 := time.Unix(69, 0)
+		pointer := map[int64]*time.Time{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := TimeValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_TimeValueOr(t *testing.T) {
+	t.Run("time.Time", func(t *testing.T) {
+		pointer := time.Unix(42, 0)
+
+		assert.Equal(t, pointer, TimeValueOr(&pointer, time.Unix(69, 0)))
+		assert.Equal(t, time.Unix(69, 0), TimeValueOr(nil, time.Unix(69, 0)))
+	})
+
+	t.Run("time.Time/slice", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		pointer := []*time.Time{&p1, nil}
+
+		value := TimeValueSliceOr(pointer, time.Unix(69, 0))
+		assert.Equal(t, []time.Time{time.Unix(42, 0), time.Unix(69, 0)}, value)
+	})
+
+	t.Run("time.Time/map", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		pointer := map[string]*time.Time{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := TimeValueMapOr(pointer, time.Unix(69, 0))
+		assert.Equal(t, map[string]time.Time{"foo": time.Unix(42, 0), "bar": time.Unix(69, 0)}, value)
+	})
+
+	t.Run("time.Time/map/int64", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		pointer := map[int64]*time.Time{42: &p1, 69: nil}
+
+		value := TimeValueMapOrOf(pointer, time.Unix(69, 0))
+		assert.Equal(t, map[int64]time.Time{42: time.Unix(42, 0), 69: time.Unix(69, 0)}, value)
+	})
+}
+
+func Test_TimeValueWith(t *testing.T) {
+	t.Run("time.Time/slice", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		pointer := []*time.Time{&p1, nil}
+
+		value, err := TimeValueSliceWith(pointer, DefaultNil(time.Unix(69, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, []time.Time{time.Unix(42, 0), time.Unix(69, 0)}, value)
+	})
+
+	t.Run("time.Time/map", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		pointer := map[string]*time.Time{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := TimeValueMapWith(pointer, SkipNil[time.Time]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]time.Time{"foo": time.Unix(42, 0)}, value)
+	})
+
+	t.Run("time.Time/map/int64", func(t *testing.T) {
+		p1 := time.Unix(42, 0)
+		pointer := map[int64]*time.Time{42: &p1, 69: nil}
+
+		value, err := TimeValueMapWithOf(pointer, SkipNil[time.Time]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]time.Time{42: time.Unix(42, 0)}, value)
+	})
+}
+
+func Test_ToTimeNonZero(t *testing.T) {
+	var zero time.Time
+
+	t.Run("time.Time", func(t *testing.T) {
+		assert.Equal(t, time.Unix(42, 0), *ToTimeNonZero(time.Unix(42, 0)))
+		assert.Nil(t, ToTimeNonZero(zero))
+	})
+
+	t.Run("time.Time/slice", func(t *testing.T) {
+		pointer := ToTimeSliceNonZero([]time.Time{time.Unix(42, 0), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Unix(42, 0), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("time.Time/map", func(t *testing.T) {
+		pointer := ToTimeMapNonZero(map[string]time.Time{"foo": time.Unix(42, 0), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Unix(42, 0), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("time.Time/map/int64", func(t *testing.T) {
+		pointer := ToTimeMapNonZeroOf(map[int64]time.Time{42: time.Unix(42, 0), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Unix(42, 0), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseTime(t *testing.T) {
+	pointer, err := ParseTime(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Duration(t *testing.T) {
+	t.Run("time.Duration", func(t *testing.T) {
+		value := time.Duration(42)
+
+		pointer := Duration(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("time.Duration/slice", func(t *testing.T) {
+		value := []time.Duration{time.Duration(42), time.Duration(69), time.Duration(99)}
+
+		pointer := DurationSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Duration/map", func(t *testing.T) {
+		value := map[string]time.Duration{
+			"foo": time.Duration(42),
+			"bar": time.Duration(69),
+			"baz": time.Duration(99),
+		}
+
+		pointer := DurationMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Duration/map/int64", func(t *testing.T) {
+		value := map[int64]time.Duration{
+			42: time.Duration(42),
+			69: time.Duration(69),
+		}
+
+		pointer := DurationMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_DurationValue(t *testing.T) {
+	t.Run("time.Duration", func(t *testing.T) {
+		pointer := time.Duration(42)
+
+		value := DurationValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("time.Duration/slice", func(t *testing.T) {
+		p1 := time.Duration(42)
+		p2 := time.Duration(69)
+		p3 := time.Duration(99)
+		pointer := []*time.Duration{&p1, &p2, &p3}
+
+		value := DurationValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Duration/map", func(t *testing.T) {
+		p1 := time.Duration(42)
+		p2 := time.Duration(69)
+		p3 := time.Duration(99)
+		pointer := map[string]*time.Duration{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := DurationValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Duration/map/int64", func(t *testing.T) {
+		p1 := time.Duration(42)
+		p2 := time.Duration(69)
+		pointer := map[int64]*time.Duration{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := DurationValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_DurationValueOr(t *testing.T) {
+	t.Run("time.Duration", func(t *testing.T) {
+		pointer := time.Duration(42)
+
+		assert.Equal(t, pointer, DurationValueOr(&pointer, time.Duration(69)))
+		assert.Equal(t, time.Duration(69), DurationValueOr(nil, time.Duration(69)))
+	})
+
+	t.Run("time.Duration/slice", func(t *testing.T) {
+		p1 := time.Duration(42)
+		pointer := []*time.Duration{&p1, nil}
+
+		value := DurationValueSliceOr(pointer, time.Duration(69))
+		assert.Equal(t, []time.Duration{time.Duration(42), time.Duration(69)}, value)
+	})
+
+	t.Run("time.Duration/map", func(t *testing.T) {
+		p1 := time.Duration(42)
+		pointer := map[string]*time.Duration{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := DurationValueMapOr(pointer, time.Duration(69))
+		assert.Equal(t, map[string]time.Duration{"foo": time.Duration(42), "bar": time.Duration(69)}, value)
+	})
+
+	t.Run("time.Duration/map/int64", func(t *testing.T) {
+		p1 := time.Duration(42)
+		pointer := map[int64]*time.Duration{42: &p1, 69: nil}
+
+		value := DurationValueMapOrOf(pointer, time.Duration(69))
+		assert.Equal(t, map[int64]time.Duration{42: time.Duration(42), 69: time.Duration(69)}, value)
+	})
+}
+
+func Test_DurationValueWith(t *testing.T) {
+	t.Run("time.Duration/slice", func(t *testing.T) {
+		p1 := time.Duration(42)
+		pointer := []*time.Duration{&p1, nil}
+
+		value, err := DurationValueSliceWith(pointer, DefaultNil(time.Duration(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []time.Duration{time.Duration(42), time.Duration(69)}, value)
+	})
+
+	t.Run("time.Duration/map", func(t *testing.T) {
+		p1 := time.Duration(42)
+		pointer := map[string]*time.Duration{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := DurationValueMapWith(pointer, SkipNil[time.Duration]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]time.Duration{"foo": time.Duration(42)}, value)
+	})
+
+	t.Run("time.Duration/map/int64", func(t *testing.T) {
+		p1 := time.Duration(42)
+		pointer := map[int64]*time.Duration{42: &p1, 69: nil}
+
+		value, err := DurationValueMapWithOf(pointer, SkipNil[time.Duration]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]time.Duration{42: time.Duration(42)}, value)
+	})
+}
+
+func Test_ToDurationNonZero(t *testing.T) {
+	var zero time.Duration
+
+	t.Run("time.Duration", func(t *testing.T) {
+		assert.Equal(t, time.Duration(42), *ToDurationNonZero(time.Duration(42)))
+		assert.Nil(t, ToDurationNonZero(zero))
+	})
+
+	t.Run("time.Duration/slice", func(t *testing.T) {
+		pointer := ToDurationSliceNonZero([]time.Duration{time.Duration(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Duration(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("time.Duration/map", func(t *testing.T) {
+		pointer := ToDurationMapNonZero(map[string]time.Duration{"foo": time.Duration(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Duration(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("time.Duration/map/int64", func(t *testing.T) {
+		pointer := ToDurationMapNonZeroOf(map[int64]time.Duration{42: time.Duration(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Duration(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseDuration(t *testing.T) {
+	pointer, err := ParseDuration(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Month(t *testing.T) {
+	t.Run("time.Month", func(t *testing.T) {
+		value := time.Month(42)
+
+		pointer := Month(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("time.Month/slice", func(t *testing.T) {
+		value := []time.Month{time.Month(42), time.Month(69), time.Month(99)}
+
+		pointer := MonthSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Month/map", func(t *testing.T) {
+		value := map[string]time.Month{
+			"foo": time.Month(42),
+			"bar": time.Month(69),
+			"baz": time.Month(99),
+		}
+
+		pointer := MonthMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Month/map/int64", func(t *testing.T) {
+		value := map[int64]time.Month{
+			42: time.Month(42),
+			69: time.Month(69),
+		}
+
+		pointer := MonthMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_MonthValue(t *testing.T) {
+	t.Run("time.Month", func(t *testing.T) {
+		pointer := time.Month(42)
+
+		value := MonthValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("time.Month/slice", func(t *testing.T) {
+		p1 := time.Month(42)
+		p2 := time.Month(69)
+		p3 := time.Month(99)
+		pointer := []*time.Month{&p1, &p2, &p3}
+
+		value := MonthValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Month/map", func(t *testing.T) {
+		p1 := time.Month(42)
+		p2 := time.Month(69)
+		p3 := time.Month(99)
+		pointer := map[string]*time.Month{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := MonthValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Month/map/int64", func(t *testing.T) {
+		p1 := time.Month(42)
+		p2 := time.Month(69)
+		pointer := map[int64]*time.Month{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := MonthValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_MonthValueOr(t *testing.T) {
+	t.Run("time.Month", func(t *testing.T) {
+		pointer := time.Month(42)
+
+		assert.Equal(t, pointer, MonthValueOr(&pointer, time.Month(69)))
+		assert.Equal(t, time.Month(69), MonthValueOr(nil, time.Month(69)))
+	})
+
+	t.Run("time.Month/slice", func(t *testing.T) {
+		p1 := time.Month(42)
+		pointer := []*time.Month{&p1, nil}
+
+		value := MonthValueSliceOr(pointer, time.Month(69))
+		assert.Equal(t, []time.Month{time.Month(42), time.Month(69)}, value)
+	})
+
+	t.Run("time.Month/map", func(t *testing.T) {
+		p1 := time.Month(42)
+		pointer := map[string]*time.Month{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := MonthValueMapOr(pointer, time.Month(69))
+		assert.Equal(t, map[string]time.Month{"foo": time.Month(42), "bar": time.Month(69)}, value)
+	})
+
+	t.Run("time.Month/map/int64", func(t *testing.T) {
+		p1 := time.Month(42)
+		pointer := map[int64]*time.Month{42: &p1, 69: nil}
+
+		value := MonthValueMapOrOf(pointer, time.Month(69))
+		assert.Equal(t, map[int64]time.Month{42: time.Month(42), 69: time.Month(69)}, value)
+	})
+}
+
+func Test_MonthValueWith(t *testing.T) {
+	t.Run("time.Month/slice", func(t *testing.T) {
+		p1 := time.Month(42)
+		pointer := []*time.Month{&p1, nil}
+
+		value, err := MonthValueSliceWith(pointer, DefaultNil(time.Month(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []time.Month{time.Month(42), time.Month(69)}, value)
+	})
+
+	t.Run("time.Month/map", func(t *testing.T) {
+		p1 := time.Month(42)
+		pointer := map[string]*time.Month{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := MonthValueMapWith(pointer, SkipNil[time.Month]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]time.Month{"foo": time.Month(42)}, value)
+	})
+
+	t.Run("time.Month/map/int64", func(t *testing.T) {
+		p1 := time.Month(42)
+		pointer := map[int64]*time.Month{42: &p1, 69: nil}
+
+		value, err := MonthValueMapWithOf(pointer, SkipNil[time.Month]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]time.Month{42: time.Month(42)}, value)
+	})
+}
+
+func Test_ToMonthNonZero(t *testing.T) {
+	var zero time.Month
+
+	t.Run("time.Month", func(t *testing.T) {
+		assert.Equal(t, time.Month(42), *ToMonthNonZero(time.Month(42)))
+		assert.Nil(t, ToMonthNonZero(zero))
+	})
+
+	t.Run("time.Month/slice", func(t *testing.T) {
+		pointer := ToMonthSliceNonZero([]time.Month{time.Month(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Month(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("time.Month/map", func(t *testing.T) {
+		pointer := ToMonthMapNonZero(map[string]time.Month{"foo": time.Month(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Month(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("time.Month/map/int64", func(t *testing.T) {
+		pointer := ToMonthMapNonZeroOf(map[int64]time.Month{42: time.Month(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Month(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseMonth(t *testing.T) {
+	pointer, err := ParseMonth(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_Weekday(t *testing.T) {
+	t.Run("time.Weekday", func(t *testing.T) {
+		value := time.Weekday(42)
+
+		pointer := Weekday(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("time.Weekday/slice", func(t *testing.T) {
+		value := []time.Weekday{time.Weekday(42), time.Weekday(69), time.Weekday(99)}
+
+		pointer := WeekdaySlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Weekday/map", func(t *testing.T) {
+		value := map[string]time.Weekday{
+			"foo": time.Weekday(42),
+			"bar": time.Weekday(69),
+			"baz": time.Weekday(99),
+		}
+
+		pointer := WeekdayMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("time.Weekday/map/int64", func(t *testing.T) {
+		value := map[int64]time.Weekday{
+			42: time.Weekday(42),
+			69: time.Weekday(69),
+		}
+
+		pointer := WeekdayMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_WeekdayValue(t *testing.T) {
+	t.Run("time.Weekday", func(t *testing.T) {
+		pointer := time.Weekday(42)
+
+		value := WeekdayValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("time.Weekday/slice", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		p2 := time.Weekday(69)
+		p3 := time.Weekday(99)
+		pointer := []*time.Weekday{&p1, &p2, &p3}
+
+		value := WeekdayValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Weekday/map", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		p2 := time.Weekday(69)
+		p3 := time.Weekday(99)
+		pointer := map[string]*time.Weekday{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := WeekdayValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("time.Weekday/map/int64", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		p2 := time.Weekday(69)
+		pointer := map[int64]*time.Weekday{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := WeekdayValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_WeekdayValueOr(t *testing.T) {
+	t.Run("time.Weekday", func(t *testing.T) {
+		pointer := time.Weekday(42)
+
+		assert.Equal(t, pointer, WeekdayValueOr(&pointer, time.Weekday(69)))
+		assert.Equal(t, time.Weekday(69), WeekdayValueOr(nil, time.Weekday(69)))
+	})
+
+	t.Run("time.Weekday/slice", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		pointer := []*time.Weekday{&p1, nil}
+
+		value := WeekdayValueSliceOr(pointer, time.Weekday(69))
+		assert.Equal(t, []time.Weekday{time.Weekday(42), time.Weekday(69)}, value)
+	})
+
+	t.Run("time.Weekday/map", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		pointer := map[string]*time.Weekday{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := WeekdayValueMapOr(pointer, time.Weekday(69))
+		assert.Equal(t, map[string]time.Weekday{"foo": time.Weekday(42), "bar": time.Weekday(69)}, value)
+	})
+
+	t.Run("time.Weekday/map/int64", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		pointer := map[int64]*time.Weekday{42: &p1, 69: nil}
+
+		value := WeekdayValueMapOrOf(pointer, time.Weekday(69))
+		assert.Equal(t, map[int64]time.Weekday{42: time.Weekday(42), 69: time.Weekday(69)}, value)
+	})
+}
+
+func Test_WeekdayValueWith(t *testing.T) {
+	t.Run("time.Weekday/slice", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		pointer := []*time.Weekday{&p1, nil}
+
+		value, err := WeekdayValueSliceWith(pointer, DefaultNil(time.Weekday(69)))
+		require.NoError(t, err)
+		assert.Equal(t, []time.Weekday{time.Weekday(42), time.Weekday(69)}, value)
+	})
+
+	t.Run("time.Weekday/map", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		pointer := map[string]*time.Weekday{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := WeekdayValueMapWith(pointer, SkipNil[time.Weekday]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]time.Weekday{"foo": time.Weekday(42)}, value)
+	})
+
+	t.Run("time.Weekday/map/int64", func(t *testing.T) {
+		p1 := time.Weekday(42)
+		pointer := map[int64]*time.Weekday{42: &p1, 69: nil}
+
+		value, err := WeekdayValueMapWithOf(pointer, SkipNil[time.Weekday]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]time.Weekday{42: time.Weekday(42)}, value)
+	})
+}
+
+func Test_ToWeekdayNonZero(t *testing.T) {
+	var zero time.Weekday
+
+	t.Run("time.Weekday", func(t *testing.T) {
+		assert.Equal(t, time.Weekday(42), *ToWeekdayNonZero(time.Weekday(42)))
+		assert.Nil(t, ToWeekdayNonZero(zero))
+	})
+
+	t.Run("time.Weekday/slice", func(t *testing.T) {
+		pointer := ToWeekdaySliceNonZero([]time.Weekday{time.Weekday(42), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Weekday(42), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("time.Weekday/map", func(t *testing.T) {
+		pointer := ToWeekdayMapNonZero(map[string]time.Weekday{"foo": time.Weekday(42), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Weekday(42), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("time.Weekday/map/int64", func(t *testing.T) {
+		pointer := ToWeekdayMapNonZeroOf(map[int64]time.Weekday{42: time.Weekday(42), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, time.Weekday(42), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseWeekday(t *testing.T) {
+	pointer, err := ParseWeekday(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_RawMessage(t *testing.T) {
+	t.Run("json.RawMessage", func(t *testing.T) {
+		value := json.RawMessage("42")
+
+		pointer := RawMessage(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("json.RawMessage/slice", func(t *testing.T) {
+		value := []json.RawMessage{json.RawMessage("42"), json.RawMessage("69"), json.RawMessage("99")}
+
+		pointer := RawMessageSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("json.RawMessage/map", func(t *testing.T) {
+		value := map[string]json.RawMessage{
+			"foo": json.RawMessage("42"),
+			"bar": json.RawMessage("69"),
+			"baz": json.RawMessage("99"),
+		}
+
+		pointer := RawMessageMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("json.RawMessage/map/int64", func(t *testing.T) {
+		value := map[int64]json.RawMessage{
+			42: json.RawMessage("42"),
+			69: json.RawMessage("69"),
+		}
+
+		pointer := RawMessageMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_RawMessageValue(t *testing.T) {
+	t.Run("json.RawMessage", func(t *testing.T) {
+		pointer := json.RawMessage("42")
+
+		value := RawMessageValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("json.RawMessage/slice", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		p2 := json.RawMessage("69")
+		p3 := json.RawMessage("99")
+		pointer := []*json.RawMessage{&p1, &p2, &p3}
+
+		value := RawMessageValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("json.RawMessage/map", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		p2 := json.RawMessage("69")
+		p3 := json.RawMessage("99")
+		pointer := map[string]*json.RawMessage{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := RawMessageValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("json.RawMessage/map/int64", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		p2 := json.RawMessage("69")
+		pointer := map[int64]*json.RawMessage{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := RawMessageValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_RawMessageValueOr(t *testing.T) {
+	t.Run("json.RawMessage", func(t *testing.T) {
+		pointer := json.RawMessage("42")
+
+		assert.Equal(t, pointer, RawMessageValueOr(&pointer, json.RawMessage("69")))
+		assert.Equal(t, json.RawMessage("69"), RawMessageValueOr(nil, json.RawMessage("69")))
+	})
+
+	t.Run("json.RawMessage/slice", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		pointer := []*json.RawMessage{&p1, nil}
+
+		value := RawMessageValueSliceOr(pointer, json.RawMessage("69"))
+		assert.Equal(t, []json.RawMessage{json.RawMessage("42"), json.RawMessage("69")}, value)
+	})
+
+	t.Run("json.RawMessage/map", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		pointer := map[string]*json.RawMessage{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := RawMessageValueMapOr(pointer, json.RawMessage("69"))
+		assert.Equal(t, map[string]json.RawMessage{"foo": json.RawMessage("42"), "bar": json.RawMessage("69")}, value)
+	})
+
+	t.Run("json.RawMessage/map/int64", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		pointer := map[int64]*json.RawMessage{42: &p1, 69: nil}
+
+		value := RawMessageValueMapOrOf(pointer, json.RawMessage("69"))
+		assert.Equal(t, map[int64]json.RawMessage{42: json.RawMessage("42"), 69: json.RawMessage("69")}, value)
+	})
+}
+
+func Test_RawMessageValueWith(t *testing.T) {
+	t.Run("json.RawMessage/slice", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		pointer := []*json.RawMessage{&p1, nil}
+
+		value, err := RawMessageValueSliceWith(pointer, DefaultNil(json.RawMessage("69")))
+		require.NoError(t, err)
+		assert.Equal(t, []json.RawMessage{json.RawMessage("42"), json.RawMessage("69")}, value)
+	})
+
+	t.Run("json.RawMessage/map", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		pointer := map[string]*json.RawMessage{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := RawMessageValueMapWith(pointer, SkipNil[json.RawMessage]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]json.RawMessage{"foo": json.RawMessage("42")}, value)
+	})
+
+	t.Run("json.RawMessage/map/int64", func(t *testing.T) {
+		p1 := json.RawMessage("42")
+		pointer := map[int64]*json.RawMessage{42: &p1, 69: nil}
+
+		value, err := RawMessageValueMapWithOf(pointer, SkipNil[json.RawMessage]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]json.RawMessage{42: json.RawMessage("42")}, value)
+	})
+}
+
+func Test_ToRawMessageNonEmpty(t *testing.T) {
+	var zero json.RawMessage
+
+	t.Run("json.RawMessage", func(t *testing.T) {
+		assert.Equal(t, json.RawMessage("42"), *ToRawMessageNonEmpty(json.RawMessage("42")))
+		assert.Nil(t, ToRawMessageNonEmpty(zero))
+	})
+
+	t.Run("json.RawMessage/slice", func(t *testing.T) {
+		pointer := ToRawMessageSliceNonEmpty([]json.RawMessage{json.RawMessage("42"), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, json.RawMessage("42"), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("json.RawMessage/map", func(t *testing.T) {
+		pointer := ToRawMessageMapNonEmpty(map[string]json.RawMessage{"foo": json.RawMessage("42"), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, json.RawMessage("42"), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("json.RawMessage/map/int64", func(t *testing.T) {
+		pointer := ToRawMessageMapNonEmptyOf(map[int64]json.RawMessage{42: json.RawMessage("42"), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, json.RawMessage("42"), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseRawMessage(t *testing.T) {
+	pointer, err := ParseRawMessage(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_NetipAddr(t *testing.T) {
+	t.Run("netip.Addr", func(t *testing.T) {
+		value := netip.MustParseAddr("127.0.0.1")
+
+		pointer := NetipAddr(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("netip.Addr/slice", func(t *testing.T) {
+		value := []netip.Addr{netip.MustParseAddr("127.0.0.1"), netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("::1")}
+
+		pointer := NetipAddrSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("netip.Addr/map", func(t *testing.T) {
+		value := map[string]netip.Addr{
+			"foo": netip.MustParseAddr("127.0.0.1"),
+			"bar": netip.MustParseAddr("10.0.0.1"),
+			"baz": netip.MustParseAddr("::1"),
+		}
+
+		pointer := NetipAddrMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("netip.Addr/map/int64", func(t *testing.T) {
+		value := map[int64]netip.Addr{
+			42: netip.MustParseAddr("127.0.0.1"),
+			69: netip.MustParseAddr("10.0.0.1"),
+		}
+
+		pointer := NetipAddrMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_NetipAddrValue(t *testing.T) {
+	t.Run("netip.Addr", func(t *testing.T) {
+		pointer := netip.MustParseAddr("127.0.0.1")
+
+		value := NetipAddrValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("netip.Addr/slice", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		p2 := netip.MustParseAddr("10.0.0.1")
+		p3 := netip.MustParseAddr("::1")
+		pointer := []*netip.Addr{&p1, &p2, &p3}
+
+		value := NetipAddrValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("netip.Addr/map", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		p2 := netip.MustParseAddr("10.0.0.1")
+		p3 := netip.MustParseAddr("::1")
+		pointer := map[string]*netip.Addr{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := NetipAddrValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("netip.Addr/map/int64", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		p2 := netip.MustParseAddr("10.0.0.1")
+		pointer := map[int64]*netip.Addr{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := NetipAddrValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_NetipAddrValueOr(t *testing.T) {
+	t.Run("netip.Addr", func(t *testing.T) {
+		pointer := netip.MustParseAddr("127.0.0.1")
+
+		assert.Equal(t, pointer, NetipAddrValueOr(&pointer, netip.MustParseAddr("10.0.0.1")))
+		assert.Equal(t, netip.MustParseAddr("10.0.0.1"), NetipAddrValueOr(nil, netip.MustParseAddr("10.0.0.1")))
+	})
+
+	t.Run("netip.Addr/slice", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		pointer := []*netip.Addr{&p1, nil}
+
+		value := NetipAddrValueSliceOr(pointer, netip.MustParseAddr("10.0.0.1"))
+		assert.Equal(t, []netip.Addr{netip.MustParseAddr("127.0.0.1"), netip.MustParseAddr("10.0.0.1")}, value)
+	})
+
+	t.Run("netip.Addr/map", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		pointer := map[string]*netip.Addr{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := NetipAddrValueMapOr(pointer, netip.MustParseAddr("10.0.0.1"))
+		assert.Equal(t, map[string]netip.Addr{"foo": netip.MustParseAddr("127.0.0.1"), "bar": netip.MustParseAddr("10.0.0.1")}, value)
+	})
+
+	t.Run("netip.Addr/map/int64", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		pointer := map[int64]*netip.Addr{42: &p1, 69: nil}
+
+		value := NetipAddrValueMapOrOf(pointer, netip.MustParseAddr("10.0.0.1"))
+		assert.Equal(t, map[int64]netip.Addr{42: netip.MustParseAddr("127.0.0.1"), 69: netip.MustParseAddr("10.0.0.1")}, value)
+	})
+}
+
+func Test_NetipAddrValueWith(t *testing.T) {
+	t.Run("netip.Addr/slice", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		pointer := []*netip.Addr{&p1, nil}
+
+		value, err := NetipAddrValueSliceWith(pointer, DefaultNil(netip.MustParseAddr("10.0.0.1")))
+		require.NoError(t, err)
+		assert.Equal(t, []netip.Addr{netip.MustParseAddr("127.0.0.1"), netip.MustParseAddr("10.0.0.1")}, value)
+	})
+
+	t.Run("netip.Addr/map", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		pointer := map[string]*netip.Addr{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := NetipAddrValueMapWith(pointer, SkipNil[netip.Addr]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]netip.Addr{"foo": netip.MustParseAddr("127.0.0.1")}, value)
+	})
+
+	t.Run("netip.Addr/map/int64", func(t *testing.T) {
+		p1 := netip.MustParseAddr("127.0.0.1")
+		pointer := map[int64]*netip.Addr{42: &p1, 69: nil}
+
+		value, err := NetipAddrValueMapWithOf(pointer, SkipNil[netip.Addr]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]netip.Addr{42: netip.MustParseAddr("127.0.0.1")}, value)
+	})
+}
+
+func Test_ToNetipAddrNonZero(t *testing.T) {
+	var zero netip.Addr
+
+	t.Run("netip.Addr", func(t *testing.T) {
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), *ToNetipAddrNonZero(netip.MustParseAddr("127.0.0.1")))
+		assert.Nil(t, ToNetipAddrNonZero(zero))
+	})
+
+	t.Run("netip.Addr/slice", func(t *testing.T) {
+		pointer := ToNetipAddrSliceNonZero([]netip.Addr{netip.MustParseAddr("127.0.0.1"), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("netip.Addr/map", func(t *testing.T) {
+		pointer := ToNetipAddrMapNonZero(map[string]netip.Addr{"foo": netip.MustParseAddr("127.0.0.1"), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("netip.Addr/map/int64", func(t *testing.T) {
+		pointer := ToNetipAddrMapNonZeroOf(map[int64]netip.Addr{42: netip.MustParseAddr("127.0.0.1"), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseNetipAddr(t *testing.T) {
+	pointer, err := ParseNetipAddr(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_NetipAddrPort(t *testing.T) {
+	t.Run("netip.AddrPort", func(t *testing.T) {
+		value := netip.MustParseAddrPort("127.0.0.1:42")
+
+		pointer := NetipAddrPort(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("netip.AddrPort/slice", func(t *testing.T) {
+		value := []netip.AddrPort{netip.MustParseAddrPort("127.0.0.1:42"), netip.MustParseAddrPort("10.0.0.1:69"), netip.MustParseAddrPort("[::1]:99")}
+
+		pointer := NetipAddrPortSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("netip.AddrPort/map", func(t *testing.T) {
+		value := map[string]netip.AddrPort{
+			"foo": netip.MustParseAddrPort("127.0.0.1:42"),
+			"bar": netip.MustParseAddrPort("10.0.0.1:69"),
+			"baz": netip.MustParseAddrPort("[::1]:99"),
+		}
+
+		pointer := NetipAddrPortMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("netip.AddrPort/map/int64", func(t *testing.T) {
+		value := map[int64]netip.AddrPort{
+			42: netip.MustParseAddrPort("127.0.0.1:42"),
+			69: netip.MustParseAddrPort("10.0.0.1:69"),
+		}
+
+		pointer := NetipAddrPortMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_NetipAddrPortValue(t *testing.T) {
+	t.Run("netip.AddrPort", func(t *testing.T) {
+		pointer := netip.MustParseAddrPort("127.0.0.1:42")
+
+		value := NetipAddrPortValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("netip.AddrPort/slice", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		p2 := netip.MustParseAddrPort("10.0.0.1:69")
+		p3 := netip.MustParseAddrPort("[::1]:99")
+		pointer := []*netip.AddrPort{&p1, &p2, &p3}
+
+		value := NetipAddrPortValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("netip.AddrPort/map", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		p2 := netip.MustParseAddrPort("10.0.0.1:69")
+		p3 := netip.MustParseAddrPort("[::1]:99")
+		pointer := map[string]*netip.AddrPort{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := NetipAddrPortValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("netip.AddrPort/map/int64", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		p2 := netip.MustParseAddrPort("10.0.0.1:69")
+		pointer := map[int64]*netip.AddrPort{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := NetipAddrPortValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_NetipAddrPortValueOr(t *testing.T) {
+	t.Run("netip.AddrPort", func(t *testing.T) {
+		pointer := netip.MustParseAddrPort("127.0.0.1:42")
+
+		assert.Equal(t, pointer, NetipAddrPortValueOr(&pointer, netip.MustParseAddrPort("10.0.0.1:69")))
+		assert.Equal(t, netip.MustParseAddrPort("10.0.0.1:69"), NetipAddrPortValueOr(nil, netip.MustParseAddrPort("10.0.0.1:69")))
+	})
+
+	t.Run("netip.AddrPort/slice", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		pointer := []*netip.AddrPort{&p1, nil}
+
+		value := NetipAddrPortValueSliceOr(pointer, netip.MustParseAddrPort("10.0.0.1:69"))
+		assert.Equal(t, []netip.AddrPort{netip.MustParseAddrPort("127.0.0.1:42"), netip.MustParseAddrPort("10.0.0.1:69")}, value)
+	})
+
+	t.Run("netip.AddrPort/map", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		pointer := map[string]*netip.AddrPort{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := NetipAddrPortValueMapOr(pointer, netip.MustParseAddrPort("10.0.0.1:69"))
+		assert.Equal(t, map[string]netip.AddrPort{"foo": netip.MustParseAddrPort("127.0.0.1:42"), "bar": netip.MustParseAddrPort("10.0.0.1:69")}, value)
+	})
+
+	t.Run("netip.AddrPort/map/int64", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		pointer := map[int64]*netip.AddrPort{42: &p1, 69: nil}
+
+		value := NetipAddrPortValueMapOrOf(pointer, netip.MustParseAddrPort("10.0.0.1:69"))
+		assert.Equal(t, map[int64]netip.AddrPort{42: netip.MustParseAddrPort("127.0.0.1:42"), 69: netip.MustParseAddrPort("10.0.0.1:69")}, value)
+	})
+}
+
+func Test_NetipAddrPortValueWith(t *testing.T) {
+	t.Run("netip.AddrPort/slice", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		pointer := []*netip.AddrPort{&p1, nil}
+
+		value, err := NetipAddrPortValueSliceWith(pointer, DefaultNil(netip.MustParseAddrPort("10.0.0.1:69")))
+		require.NoError(t, err)
+		assert.Equal(t, []netip.AddrPort{netip.MustParseAddrPort("127.0.0.1:42"), netip.MustParseAddrPort("10.0.0.1:69")}, value)
+	})
+
+	t.Run("netip.AddrPort/map", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		pointer := map[string]*netip.AddrPort{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := NetipAddrPortValueMapWith(pointer, SkipNil[netip.AddrPort]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]netip.AddrPort{"foo": netip.MustParseAddrPort("127.0.0.1:42")}, value)
+	})
+
+	t.Run("netip.AddrPort/map/int64", func(t *testing.T) {
+		p1 := netip.MustParseAddrPort("127.0.0.1:42")
+		pointer := map[int64]*netip.AddrPort{42: &p1, 69: nil}
+
+		value, err := NetipAddrPortValueMapWithOf(pointer, SkipNil[netip.AddrPort]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]netip.AddrPort{42: netip.MustParseAddrPort("127.0.0.1:42")}, value)
+	})
+}
+
+func Test_ToNetipAddrPortNonZero(t *testing.T) {
+	var zero netip.AddrPort
+
+	t.Run("netip.AddrPort", func(t *testing.T) {
+		assert.Equal(t, netip.MustParseAddrPort("127.0.0.1:42"), *ToNetipAddrPortNonZero(netip.MustParseAddrPort("127.0.0.1:42")))
+		assert.Nil(t, ToNetipAddrPortNonZero(zero))
+	})
+
+	t.Run("netip.AddrPort/slice", func(t *testing.T) {
+		pointer := ToNetipAddrPortSliceNonZero([]netip.AddrPort{netip.MustParseAddrPort("127.0.0.1:42"), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParseAddrPort("127.0.0.1:42"), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("netip.AddrPort/map", func(t *testing.T) {
+		pointer := ToNetipAddrPortMapNonZero(map[string]netip.AddrPort{"foo": netip.MustParseAddrPort("127.0.0.1:42"), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParseAddrPort("127.0.0.1:42"), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("netip.AddrPort/map/int64", func(t *testing.T) {
+		pointer := ToNetipAddrPortMapNonZeroOf(map[int64]netip.AddrPort{42: netip.MustParseAddrPort("127.0.0.1:42"), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParseAddrPort("127.0.0.1:42"), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseNetipAddrPort(t *testing.T) {
+	pointer, err := ParseNetipAddrPort(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
+
+func Test_NetipPrefix(t *testing.T) {
+	t.Run("netip.Prefix", func(t *testing.T) {
+		value := netip.MustParsePrefix("127.0.0.0/8")
+
+		pointer := NetipPrefix(value)
+		assert.Equal(t, value, *pointer)
+	})
+
+	t.Run("netip.Prefix/slice", func(t *testing.T) {
+		value := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("::1/128")}
+
+		pointer := NetipPrefixSlice(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("netip.Prefix/map", func(t *testing.T) {
+		value := map[string]netip.Prefix{
+			"foo": netip.MustParsePrefix("127.0.0.0/8"),
+			"bar": netip.MustParsePrefix("10.0.0.0/8"),
+			"baz": netip.MustParsePrefix("::1/128"),
+		}
+
+		pointer := NetipPrefixMap(value)
+		require.Len(t, pointer, len(value))
+		for i := range value {
+			assert.Equal(t, value[i], *pointer[i])
+		}
+	})
+
+	t.Run("netip.Prefix/map/int64", func(t *testing.T) {
+		value := map[int64]netip.Prefix{
+			42: netip.MustParsePrefix("127.0.0.0/8"),
+			69: netip.MustParsePrefix("10.0.0.0/8"),
+		}
+
+		pointer := NetipPrefixMapOf(value)
+		require.Len(t, pointer, len(value))
+		for k := range value {
+			assert.Equal(t, value[k], *pointer[k])
+		}
+	})
+}
+
+func Test_NetipPrefixValue(t *testing.T) {
+	t.Run("netip.Prefix", func(t *testing.T) {
+		pointer := netip.MustParsePrefix("127.0.0.0/8")
+
+		value := NetipPrefixValue(&pointer)
+		assert.Equal(t, pointer, value)
+	})
+
+	t.Run("netip.Prefix/slice", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		p2 := netip.MustParsePrefix("10.0.0.0/8")
+		p3 := netip.MustParsePrefix("::1/128")
+		pointer := []*netip.Prefix{&p1, &p2, &p3}
+
+		value := NetipPrefixValueSlice(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("netip.Prefix/map", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		p2 := netip.MustParsePrefix("10.0.0.0/8")
+		p3 := netip.MustParsePrefix("::1/128")
+		pointer := map[string]*netip.Prefix{
+			"foo": &p1,
+			"bar": &p2,
+			"baz": &p3,
+		}
+
+		value := NetipPrefixValueMap(pointer)
+		require.Len(t, value, len(pointer))
+		for i := range pointer {
+			assert.Equal(t, *pointer[i], value[i])
+		}
+	})
+
+	t.Run("netip.Prefix/map/int64", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		p2 := netip.MustParsePrefix("10.0.0.0/8")
+		pointer := map[int64]*netip.Prefix{
+			42: &p1,
+			69: &p2,
+		}
+
+		value := NetipPrefixValueMapOf(pointer)
+		require.Len(t, value, len(pointer))
+		for k := range pointer {
+			assert.Equal(t, *pointer[k], value[k])
+		}
+	})
+}
+
+func Test_NetipPrefixValueOr(t *testing.T) {
+	t.Run("netip.Prefix", func(t *testing.T) {
+		pointer := netip.MustParsePrefix("127.0.0.0/8")
+
+		assert.Equal(t, pointer, NetipPrefixValueOr(&pointer, netip.MustParsePrefix("10.0.0.0/8")))
+		assert.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), NetipPrefixValueOr(nil, netip.MustParsePrefix("10.0.0.0/8")))
+	})
+
+	t.Run("netip.Prefix/slice", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		pointer := []*netip.Prefix{&p1, nil}
+
+		value := NetipPrefixValueSliceOr(pointer, netip.MustParsePrefix("10.0.0.0/8"))
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/8")}, value)
+	})
+
+	t.Run("netip.Prefix/map", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		pointer := map[string]*netip.Prefix{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value := NetipPrefixValueMapOr(pointer, netip.MustParsePrefix("10.0.0.0/8"))
+		assert.Equal(t, map[string]netip.Prefix{"foo": netip.MustParsePrefix("127.0.0.0/8"), "bar": netip.MustParsePrefix("10.0.0.0/8")}, value)
+	})
+
+	t.Run("netip.Prefix/map/int64", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		pointer := map[int64]*netip.Prefix{42: &p1, 69: nil}
+
+		value := NetipPrefixValueMapOrOf(pointer, netip.MustParsePrefix("10.0.0.0/8"))
+		assert.Equal(t, map[int64]netip.Prefix{42: netip.MustParsePrefix("127.0.0.0/8"), 69: netip.MustParsePrefix("10.0.0.0/8")}, value)
+	})
+}
+
+func Test_NetipPrefixValueWith(t *testing.T) {
+	t.Run("netip.Prefix/slice", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		pointer := []*netip.Prefix{&p1, nil}
+
+		value, err := NetipPrefixValueSliceWith(pointer, DefaultNil(netip.MustParsePrefix("10.0.0.0/8")))
+		require.NoError(t, err)
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/8")}, value)
+	})
+
+	t.Run("netip.Prefix/map", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		pointer := map[string]*netip.Prefix{
+			"foo": &p1,
+			"bar": nil,
+		}
+
+		value, err := NetipPrefixValueMapWith(pointer, SkipNil[netip.Prefix]())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]netip.Prefix{"foo": netip.MustParsePrefix("127.0.0.0/8")}, value)
+	})
+
+	t.Run("netip.Prefix/map/int64", func(t *testing.T) {
+		p1 := netip.MustParsePrefix("127.0.0.0/8")
+		pointer := map[int64]*netip.Prefix{42: &p1, 69: nil}
+
+		value, err := NetipPrefixValueMapWithOf(pointer, SkipNil[netip.Prefix]())
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]netip.Prefix{42: netip.MustParsePrefix("127.0.0.0/8")}, value)
+	})
+}
+
+func Test_ToNetipPrefixNonZero(t *testing.T) {
+	var zero netip.Prefix
+
+	t.Run("netip.Prefix", func(t *testing.T) {
+		assert.Equal(t, netip.MustParsePrefix("127.0.0.0/8"), *ToNetipPrefixNonZero(netip.MustParsePrefix("127.0.0.0/8")))
+		assert.Nil(t, ToNetipPrefixNonZero(zero))
+	})
+
+	t.Run("netip.Prefix/slice", func(t *testing.T) {
+		pointer := ToNetipPrefixSliceNonZero([]netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParsePrefix("127.0.0.0/8"), *pointer[0])
+		assert.Nil(t, pointer[1])
+	})
+
+	t.Run("netip.Prefix/map", func(t *testing.T) {
+		pointer := ToNetipPrefixMapNonZero(map[string]netip.Prefix{"foo": netip.MustParsePrefix("127.0.0.0/8"), "bar": zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParsePrefix("127.0.0.0/8"), *pointer["foo"])
+		assert.Nil(t, pointer["bar"])
+	})
+
+	t.Run("netip.Prefix/map/int64", func(t *testing.T) {
+		pointer := ToNetipPrefixMapNonZeroOf(map[int64]netip.Prefix{42: netip.MustParsePrefix("127.0.0.0/8"), 69: zero})
+		require.Len(t, pointer, 2)
+		assert.Equal(t, netip.MustParsePrefix("127.0.0.0/8"), *pointer[42])
+		assert.Nil(t, pointer[69])
+	})
+}
+
+func Test_ParseNetipPrefix(t *testing.T) {
+	pointer, err := ParseNetipPrefix(" ")
+	require.NoError(t, err)
+	assert.Nil(t, pointer)
+}
@@ -0,0 +1,193 @@
+package ptr
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const dbTagName = "db"
+
+// ErrNullField is returned when a NULL column is scanned into a non-pointer field.
+var ErrNullField = errors.New("ptr: NULL scanned into a non-pointer field")
+
+var scannerType = reflect.TypeFor[sql.Scanner]()
+
+// ScanStruct scans the current row of rows into dst, which must be a non-nil
+// pointer to a struct, like rows.Scan does with a pointer per column.
+//
+// Columns map to the exported fields by their `db` tag, or else by their name
+// ignoring case, and `db:"-"` fields are never mapped. The fields of embedded
+// structs without a `db` tag are mapped too, allocating nil embedded pointers. Pointer fields are set
+// to nil on NULL and to a new value otherwise, while a NULL into a non-pointer
+// field fails with ErrNullField, unless the field is a sql.Scanner like Nullable.
+//
+//	for rows.Next() {
+//		var u User
+//		if err := ptr.ScanStruct(rows, &u); err != nil {
+//			return err
+//		}
+//	}
+func ScanStruct(rows *sql.Rows, dst any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return errStructDst
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	fields, err := scanFields(d.Elem().Type(), columns)
+	if err != nil {
+		return err
+	}
+	return scanRow(rows, d.Elem(), columns, fields)
+}
+
+// ScanAll scans every row of rows into a T, a struct or a pointer to a struct,
+// as ScanStruct does, and closes rows.
+//
+//	rows, err := db.QueryContext(ctx, "SELECT id, name FROM users")
+//	...
+//	users, err := ptr.ScanAll[User](rows)
+func ScanAll[T any](rows *sql.Rows) ([]T, error) {
+	defer rows.Close()
+
+	typ := reflect.TypeFor[T]()
+	structType := typ
+	if typ.Kind() == reflect.Pointer {
+		structType = typ.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("ptr: ScanAll type %s is not a struct or a pointer to a struct", typ)
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	fields, err := scanFields(structType, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []T
+	for rows.Next() {
+		var t T
+		v := reflect.ValueOf(&t).Elem()
+		if typ.Kind() == reflect.Pointer {
+			v.Set(reflect.New(structType))
+			v = v.Elem()
+		}
+		if err := scanRow(rows, v, columns, fields); err != nil {
+			return all, err
+		}
+		all = append(all, t)
+	}
+	return all, rows.Err()
+}
+
+// scanFields returns the index of the field of t each column maps to.
+func scanFields(t reflect.Type, columns []string) ([][]int, error) {
+	candidates := scanCandidates(t, nil, nil)
+	// shallower fields shadow the ones of embedded structs, as in Go
+	slices.SortStableFunc(candidates, func(a, b scanField) int {
+		return len(a.index) - len(b.index)
+	})
+	fields := make([][]int, len(columns))
+	for i, column := range columns {
+		for _, f := range candidates {
+			if f.tag == column || f.tag == "" && strings.EqualFold(f.name, column) {
+				fields[i] = f.index
+				break
+			}
+		}
+		if fields[i] == nil {
+			return nil, fmt.Errorf("ptr: no field of %s maps to column %q", t, column)
+		}
+	}
+	return fields, nil
+}
+
+type scanField struct {
+	name  string
+	tag   string
+	index []int
+}
+
+// scanCandidates appends the fields of t columns can map to, walking into
+// embedded structs without a `db` tag.
+func scanCandidates(t reflect.Type, index []int, fields []scanField) []scanField {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get(dbTagName), ",")
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(slices.Clone(index), i)
+		if f.Anonymous && tag == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			// unexported embedded pointers can't be allocated
+			walk := f.IsExported() || f.Type.Kind() != reflect.Pointer
+			if walk && nestedStruct(ft) && !reflect.PointerTo(ft).Implements(scannerType) {
+				fields = scanCandidates(ft, fieldIndex, fields)
+				continue
+			}
+		}
+		if f.IsExported() {
+			fields = append(fields, scanField{f.Name, tag, fieldIndex})
+		}
+	}
+	return fields
+}
+
+// fieldByIndex returns the nested field of v at index, allocating the nil
+// embedded struct pointers on the way.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// scanRow scans the current row into the fields of v. Pointer and sql.Scanner
+// fields are scanned into directly, others through a pointer to catch NULL.
+func scanRow(rows *sql.Rows, v reflect.Value, columns []string, fields [][]int) error {
+	dests := make([]any, len(fields))
+	temps := make([]reflect.Value, len(fields))
+	for i, index := range fields {
+		f := fieldByIndex(v, index)
+		if f.Kind() == reflect.Pointer || f.Addr().Type().Implements(scannerType) {
+			dests[i] = f.Addr().Interface()
+			continue
+		}
+		temps[i] = reflect.New(reflect.PointerTo(f.Type()))
+		dests[i] = temps[i].Interface()
+	}
+	if err := rows.Scan(dests...); err != nil {
+		return err
+	}
+
+	for i, p := range temps {
+		if !p.IsValid() {
+			continue
+		}
+		if p.Elem().IsNil() {
+			f := v.Type().FieldByIndex(fields[i])
+			return fmt.Errorf("%w: column %q into %s.%s of type %s", ErrNullField, columns[i], v.Type(), f.Name, f.Type)
+		}
+		fieldByIndex(v, fields[i]).Set(p.Elem().Elem())
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package ptr
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scanTestConnector is a stand-in database returning the same rows to any query.
+type scanTestConnector struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (c scanTestConnector) Connect(context.Context) (driver.Conn, error) { return scanTestConn{c}, nil }
+func (c scanTestConnector) Driver() driver.Driver                        { return scanTestDriver{c} }
+
+type scanTestDriver struct{ c scanTestConnector }
+
+func (d scanTestDriver) Open(string) (driver.Conn, error) { return scanTestConn(d), nil }
+
+type scanTestConn struct{ c scanTestConnector }
+
+func (c scanTestConn) Prepare(string) (driver.Stmt, error) { return scanTestStmt(c), nil }
+func (c scanTestConn) Close() error                        { return nil }
+func (c scanTestConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type scanTestStmt struct{ c scanTestConnector }
+
+func (s scanTestStmt) Close() error  { return nil }
+func (s scanTestStmt) NumInput() int { return -1 }
+func (s scanTestStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s scanTestStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &scanTestRows{c: s.c}, nil
+}
+
+type scanTestRows struct {
+	c scanTestConnector
+	i int
+}
+
+func (r *scanTestRows) Columns() []string { return r.c.columns }
+func (r *scanTestRows) Close() error      { return nil }
+func (r *scanTestRows) Next(dest []driver.Value) error {
+	if r.i == len(r.c.rows) {
+		return io.EOF
+	}
+	copy(dest, r.c.rows[r.i])
+	r.i++
+	return nil
+}
+
+func queryTestRows(t *testing.T, columns []string, rows ...[]driver.Value) *sql.Rows {
+	t.Helper()
+	db := sql.OpenDB(scanTestConnector{columns, rows})
+	t.Cleanup(func() { db.Close() })
+
+	r, err := db.Query("SELECT")
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close() })
+	return r
+}
+
+type scanUser struct {
+	ID        int64 `db:"id"`
+	Name      *string
+	Email     *string          `db:"email_address"`
+	Nick      Nullable[string] `db:"nick"`
+	CreatedAt *time.Time       `db:"created_at"`
+	Internal  string           `db:"-"`
+}
+
+type scanBase struct {
+	ID        int64      `db:"id"`
+	CreatedAt *time.Time `db:"created_at"`
+}
+
+type scanEmbedded struct {
+	scanBase
+	Name *string
+}
+
+var (
+	scanColumns = []string{"id", "name", "email_address", "nick", "created_at"}
+	scanAt      = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+)
+
+func Test_ScanStruct(t *testing.T) {
+	t.Run("values and nulls", func(t *testing.T) {
+		rows := queryTestRows(t, scanColumns,
+			[]driver.Value{int64(42), "foo", nil, nil, scanAt},
+			[]driver.Value{int64(69), nil, "bar@baz.qux", "b", nil},
+		)
+
+		var users []scanUser
+		for rows.Next() {
+			var u scanUser
+			require.NoError(t, ScanStruct(rows, &u))
+			users = append(users, u)
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, []scanUser{
+			{ID: 42, Name: To("foo"), Nick: Null[string](), CreatedAt: &scanAt},
+			{ID: 69, Email: To("bar@baz.qux"), Nick: NullableOf("b")},
+		}, users)
+	})
+
+	t.Run("null into value", func(t *testing.T) {
+		rows := queryTestRows(t, scanColumns, []driver.Value{nil, "foo", nil, nil, nil})
+		require.True(t, rows.Next())
+
+		var u scanUser
+		err := ScanStruct(rows, &u)
+		assert.ErrorIs(t, err, ErrNullField)
+		assert.EqualError(t, err, `ptr: NULL scanned into a non-pointer field: column "id" into ptr.scanUser.ID of type int64`)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		rows := queryTestRows(t, []string{"id", "Internal"}, []driver.Value{int64(42), "foo"})
+		require.True(t, rows.Next())
+
+		var u scanUser
+		assert.EqualError(t, ScanStruct(rows, &u), `ptr: no field of ptr.scanUser maps to column "Internal"`)
+	})
+
+	t.Run("embedded structs", func(t *testing.T) {
+		rows := queryTestRows(t, []string{"id", "name", "created_at"}, []driver.Value{int64(42), "foo", scanAt})
+		require.True(t, rows.Next())
+
+		var row scanEmbedded
+		require.NoError(t, ScanStruct(rows, &row))
+		assert.Equal(t, scanEmbedded{scanBase: scanBase{ID: 42, CreatedAt: &scanAt}, Name: To("foo")}, row)
+
+		type Audit struct {
+			CreatedAt *time.Time `db:"created_at"`
+		}
+		type auditRow struct {
+			*Audit
+			ID int64 `db:"id"`
+		}
+		rows = queryTestRows(t, []string{"id", "created_at"}, []driver.Value{int64(42), scanAt})
+		require.True(t, rows.Next())
+
+		var audit auditRow
+		require.NoError(t, ScanStruct(rows, &audit))
+		assert.Equal(t, auditRow{Audit: &Audit{CreatedAt: &scanAt}, ID: 42}, audit)
+	})
+
+	t.Run("invalid dst", func(t *testing.T) {
+		rows := queryTestRows(t, scanColumns)
+
+		assert.ErrorIs(t, ScanStruct(rows, scanUser{}), errStructDst)
+	})
+}
+
+func Test_ScanAll(t *testing.T) {
+	t.Run("structs", func(t *testing.T) {
+		rows := queryTestRows(t, []string{"id", "name"},
+			[]driver.Value{int64(42), "foo"},
+			[]driver.Value{int64(69), nil},
+		)
+
+		users, err := ScanAll[scanUser](rows)
+		require.NoError(t, err)
+		assert.Equal(t, []scanUser{{ID: 42, Name: To("foo")}, {ID: 69}}, users)
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		rows := queryTestRows(t, []string{"id"}, []driver.Value{int64(42)})
+
+		users, err := ScanAll[*scanUser](rows)
+		require.NoError(t, err)
+		assert.Equal(t, []*scanUser{{ID: 42}}, users)
+	})
+
+	t.Run("conversion error", func(t *testing.T) {
+		rows := queryTestRows(t, []string{"id"},
+			[]driver.Value{int64(42)},
+			[]driver.Value{"foo"},
+		)
+
+		users, err := ScanAll[scanUser](rows)
+		assert.Error(t, err)
+		assert.Equal(t, []scanUser{{ID: 42}}, users)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := ScanAll[int](queryTestRows(t, []string{"id"}))
+		assert.EqualError(t, err, "ptr: ScanAll type int is not a struct or a pointer to a struct")
+	})
+}
@@ -0,0 +1,174 @@
+package ptr
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+// secretMask replaces the value of fields tagged `ptr:"secret"` in LogValue.
+const secretMask = "***"
+
+// cycleMark replaces a pointer LogValue runs into again while logging it.
+const cycleMark = "<cycle>"
+
+var logValuerType = reflect.TypeFor[slog.LogValuer]()
+
+type attrOptions struct {
+	omitNil bool
+}
+
+// AttrOption configures Attr.
+type AttrOption func(*attrOptions)
+
+// OmitNil makes Attr return an empty attribute for a nil pointer, which handlers omit.
+func OmitNil() AttrOption {
+	return func(o *attrOptions) {
+		o.omitNil = true
+	}
+}
+
+// Attr returns a slog.Attr holding the value p points to, or a null value if p is nil.
+//
+//	logger.Info("user updated", ptr.Attr("name", in.Name), ptr.Attr("age", in.Age, ptr.OmitNil()))
+func Attr[T any](key string, p *T, opts ...AttrOption) slog.Attr {
+	if p != nil {
+		return slog.Any(key, *p)
+	}
+	var o attrOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.omitNil {
+		return slog.Attr{}
+	}
+	return slog.Any(key, nil)
+}
+
+// LogValue wraps v into a slog.LogValuer that logs a struct as a group of its
+// exported fields, dereferencing pointers at any depth and skipping nil
+// pointers, slices and maps. A Nullable logs its value, null when null,
+// and is skipped when unset.
+// Fields are named after the `ptr` tag like in StructToValues, `ptr:"-"` fields
+// are skipped and `ptr:"secret"` or `ptr:"name,secret"` fields are masked.
+//
+// Nested structs become nested groups, and the elements of slices and maps are
+// dereferenced. Other values, or types implementing slog.LogValuer or fmt.Stringer,
+// are logged as is. A pointer met again inside its own value is logged as "<cycle>".
+//
+//	logger.Info("create user", "request", ptr.LogValue(in))
+func LogValue(v any) slog.LogValuer {
+	return logValuer{v}
+}
+
+type logValuer struct {
+	v any
+}
+
+func (l logValuer) LogValue() slog.Value {
+	w := logWalker{visiting: map[visitKey]bool{}}
+	return w.logValue(reflect.ValueOf(l.v))
+}
+
+type logWalker struct {
+	// visiting holds the pointers being logged, to stop on cycles.
+	visiting map[visitKey]bool
+}
+
+func (w *logWalker) logValue(v reflect.Value) slog.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return slog.AnyValue(nil)
+		}
+		if v.Kind() == reflect.Pointer {
+			key := visitKey{v.Type(), v.Pointer()}
+			if w.visiting[key] {
+				return slog.StringValue(cycleMark)
+			}
+			w.visiting[key] = true
+			defer delete(w.visiting, key)
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return slog.AnyValue(nil)
+	}
+	if !v.CanInterface() {
+		return slog.StringValue(fmt.Sprint(v))
+	}
+	if n, ok := asNullable(v); ok {
+		if !n.IsSet() || n.IsNull() {
+			return slog.AnyValue(nil)
+		}
+		return w.logValue(reflect.ValueOf(n.heldValue()))
+	}
+	if v.Type().Implements(logValuerType) || v.Type().Implements(stringerType) {
+		return slog.AnyValue(v.Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return w.logGroup(v)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		values := make([]any, v.Len())
+		for i := range values {
+			values[i] = w.logAny(v.Index(i))
+		}
+		return slog.AnyValue(values)
+	case reflect.Map:
+		if v.IsNil() {
+			break
+		}
+		values := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[fmt.Sprint(Indirect(iter.Key().Interface()))] = w.logAny(iter.Value())
+		}
+		return slog.AnyValue(values)
+	}
+	return slog.AnyValue(v.Interface())
+}
+
+// logAny returns the log value of v as a Go value for slices and maps,
+// groups becoming maps so handlers encode them like the other values.
+func (w *logWalker) logAny(v reflect.Value) any {
+	return groupAny(w.logValue(v))
+}
+
+func groupAny(v slog.Value) any {
+	if v.Kind() != slog.KindGroup {
+		return v.Any()
+	}
+	m := make(map[string]any, len(v.Group()))
+	for _, a := range v.Group() {
+		m[a.Key] = groupAny(a.Value)
+	}
+	return m
+}
+
+func (w *logWalker) logGroup(v reflect.Value) slog.Value {
+	var attrs []slog.Attr
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := parseFieldTag(f)
+		fv := v.Field(i)
+		if tag.skip || isNilValue(fv) || isNilCollection(fv) {
+			continue
+		}
+		if n, ok := asNullable(fv); ok && !n.IsSet() {
+			continue
+		}
+		if tag.secret {
+			attrs = append(attrs, slog.String(tag.name, secretMask))
+			continue
+		}
+		attrs = append(attrs, slog.Attr{Key: tag.name, Value: w.logValue(fv)})
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,135 @@
+package ptr
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logAddress struct {
+	Street *string
+	Zip    *string
+}
+
+type logRequest struct {
+	Name     *string
+	Age      *int
+	Password *string `ptr:"secret"`
+	Token    *string `ptr:"token,secret"`
+	Internal *string `ptr:"-"`
+	Email    *string `ptr:"mail"`
+	Address  *logAddress
+	Tags     []*string
+	Items    []logAddress
+	Labels   map[string]*int
+	Timeout  *time.Duration
+	Nick     Nullable[string]
+	Bio      Nullable[string]
+	Phone    Nullable[string]
+	private  *string
+}
+
+type logNode struct {
+	Name     *string
+	Parent   *logNode
+	Children []*logNode
+}
+
+// logJSON logs args with a JSON handler and returns the decoded record without its time, level and message.
+func logJSON(t *testing.T, args ...any) map[string]any {
+	t.Helper()
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", args...)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	delete(record, slog.TimeKey)
+	delete(record, slog.LevelKey)
+	delete(record, slog.MessageKey)
+	return record
+}
+
+func Test_Attr(t *testing.T) {
+	assert.Equal(t, slog.String("name", "foo"), Attr("name", To("foo")))
+	assert.Equal(t, slog.Any("name", nil), Attr[string]("name", nil))
+	assert.True(t, Attr[string]("name", nil, OmitNil()).Equal(slog.Attr{}))
+
+	assert.Equal(t, map[string]any{"name": "foo", "age": nil}, logJSON(t,
+		Attr("name", To("foo")),
+		Attr[int]("age", nil),
+		Attr[int]("omitted", nil, OmitNil()),
+	))
+}
+
+func Test_LogValue(t *testing.T) {
+	in := logRequest{
+		Name:     To("foo"),
+		Password: To("hunter2"),
+		Token:    To("abc"),
+		Internal: To("internal"),
+		Email:    To("foo@bar.baz"),
+		Address:  &logAddress{Street: To("bar")},
+		Tags:     []*string{To("a"), nil},
+		Items:    []logAddress{{Zip: To("42")}},
+		Labels:   map[string]*int{"x": To(1)},
+		Timeout:  To(time.Second),
+		Nick:     NullableOf("n"),
+		Bio:      Null[string](),
+		private:  To("private"),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		assert.Equal(t, map[string]any{
+			"request": map[string]any{
+				"Name":     "foo",
+				"Password": "***",
+				"token":    "***",
+				"mail":     "foo@bar.baz",
+				"Address":  map[string]any{"Street": "bar"},
+				"Tags":     []any{"a", nil},
+				"Items":    []any{map[string]any{"Zip": "42"}},
+				"Labels":   map[string]any{"x": float64(1)},
+				"Timeout":  float64(time.Second),
+				"Nick":     "n",
+				"Bio":      nil,
+			},
+		}, logJSON(t, "request", LogValue(&in)))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "request", LogValue(logRequest{Name: To("foo"), Timeout: To(time.Second)}))
+
+		assert.Contains(t, buf.String(), "request.Name=foo request.Timeout=1s\n")
+	})
+
+	t.Run("values", func(t *testing.T) {
+		assert.Equal(t, slog.IntValue(42), LogValue(To(To(42))).LogValue())
+		assert.Equal(t, slog.AnyValue(nil), LogValue((*logRequest)(nil)).LogValue())
+		assert.Equal(t, slog.StringValue("n"), LogValue(NullableOf("n")).LogValue())
+		assert.Equal(t, slog.AnyValue(nil), LogValue(Null[string]()).LogValue())
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		n := &logNode{Name: To("foo")}
+		n.Parent = n
+		assert.Equal(t, map[string]any{
+			"node": map[string]any{"Name": "foo", "Parent": "<cycle>"},
+		}, logJSON(t, "node", LogValue(n)))
+
+		leaf := &logNode{Name: To("bar")}
+		root := &logNode{Children: []*logNode{leaf, leaf}}
+		leaf.Parent = root
+		assert.Equal(t, map[string]any{
+			"node": map[string]any{"Children": []any{
+				map[string]any{"Name": "bar", "Parent": "<cycle>"},
+				map[string]any{"Name": "bar", "Parent": "<cycle>"},
+			}},
+		}, logJSON(t, "node", LogValue(root)))
+	})
+}
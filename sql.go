@@ -0,0 +1,39 @@
+package ptr
+
+import "database/sql"
+
+// FromNull returns a pointer to the value of v, or nil if v is not valid.
+func FromNull[T any](v sql.Null[T]) *T {
+	if !v.Valid {
+		return nil
+	}
+	return &v.V
+}
+
+// ToNull returns a valid sql.Null holding the value p points to, or an invalid one if p is nil.
+func ToNull[T any](p *T) sql.Null[T] {
+	if p == nil {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: *p, Valid: true}
+}
+
+// Scanner returns a sql.Scanner that sets *dst to a new value, or to nil on NULL.
+//
+//	err := rows.Scan(&rec.ID, ptr.Scanner(&rec.Name))
+func Scanner[T any](dst **T) sql.Scanner {
+	return scanner[T]{dst}
+}
+
+type scanner[T any] struct {
+	dst **T
+}
+
+func (s scanner[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*s.dst = FromNull(n)
+	return nil
+}
@@ -0,0 +1,136 @@
+// Code generated by ptrgen; DO NOT EDIT.
+
+package ptr
+
+import (
+	"database/sql"
+	"time"
+)
+
+// FromNullString returns a pointer to the value of v, or nil if v is not valid.
+func FromNullString(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
+
+// ToNullString returns a valid sql.NullString holding the value p points to, or an invalid one if p is nil.
+func ToNullString(p *string) sql.NullString {
+	if p == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *p, Valid: true}
+}
+
+// FromNullInt64 returns a pointer to the value of v, or nil if v is not valid.
+func FromNullInt64(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+// ToNullInt64 returns a valid sql.NullInt64 holding the value p points to, or an invalid one if p is nil.
+func ToNullInt64(p *int64) sql.NullInt64 {
+	if p == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *p, Valid: true}
+}
+
+// FromNullInt32 returns a pointer to the value of v, or nil if v is not valid.
+func FromNullInt32(v sql.NullInt32) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int32
+}
+
+// ToNullInt32 returns a valid sql.NullInt32 holding the value p points to, or an invalid one if p is nil.
+func ToNullInt32(p *int32) sql.NullInt32 {
+	if p == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *p, Valid: true}
+}
+
+// FromNullInt16 returns a pointer to the value of v, or nil if v is not valid.
+func FromNullInt16(v sql.NullInt16) *int16 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int16
+}
+
+// ToNullInt16 returns a valid sql.NullInt16 holding the value p points to, or an invalid one if p is nil.
+func ToNullInt16(p *int16) sql.NullInt16 {
+	if p == nil {
+		return sql.NullInt16{}
+	}
+	return sql.NullInt16{Int16: *p, Valid: true}
+}
+
+// FromNullByte returns a pointer to the value of v, or nil if v is not valid.
+func FromNullByte(v sql.NullByte) *byte {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Byte
+}
+
+// ToNullByte returns a valid sql.NullByte holding the value p points to, or an invalid one if p is nil.
+func ToNullByte(p *byte) sql.NullByte {
+	if p == nil {
+		return sql.NullByte{}
+	}
+	return sql.NullByte{Byte: *p, Valid: true}
+}
+
+// FromNullFloat64 returns a pointer to the value of v, or nil if v is not valid.
+func FromNullFloat64(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float64
+}
+
+// ToNullFloat64 returns a valid sql.NullFloat64 holding the value p points to, or an invalid one if p is nil.
+func ToNullFloat64(p *float64) sql.NullFloat64 {
+	if p == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *p, Valid: true}
+}
+
+// FromNullBool returns a pointer to the value of v, or nil if v is not valid.
+func FromNullBool(v sql.NullBool) *bool {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Bool
+}
+
+// ToNullBool returns a valid sql.NullBool holding the value p points to, or an invalid one if p is nil.
+func ToNullBool(p *bool) sql.NullBool {
+	if p == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *p, Valid: true}
+}
+
+// FromNullTime returns a pointer to the value of v, or nil if v is not valid.
+func FromNullTime(v sql.NullTime) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Time
+}
+
+// ToNullTime returns a valid sql.NullTime holding the value p points to, or an invalid one if p is nil.
+func ToNullTime(p *time.Time) sql.NullTime {
+	if p == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *p, Valid: true}
+}
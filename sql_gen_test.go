@@ -0,0 +1,123 @@
+// Code generated by ptrgen; DO NOT EDIT.
+
+package ptr
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NullString(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, "foo", *FromNullString(sql.NullString{String: "foo", Valid: true}))
+		assert.Nil(t, FromNullString(sql.NullString{String: "foo"}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := "foo"
+
+		assert.Equal(t, sql.NullString{String: "foo", Valid: true}, ToNullString(&value))
+		assert.Equal(t, sql.NullString{}, ToNullString(nil))
+	})
+}
+
+func Test_NullInt64(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, int64(42), *FromNullInt64(sql.NullInt64{Int64: int64(42), Valid: true}))
+		assert.Nil(t, FromNullInt64(sql.NullInt64{Int64: int64(42)}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := int64(42)
+
+		assert.Equal(t, sql.NullInt64{Int64: int64(42), Valid: true}, ToNullInt64(&value))
+		assert.Equal(t, sql.NullInt64{}, ToNullInt64(nil))
+	})
+}
+
+func Test_NullInt32(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, int32(42), *FromNullInt32(sql.NullInt32{Int32: int32(42), Valid: true}))
+		assert.Nil(t, FromNullInt32(sql.NullInt32{Int32: int32(42)}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := int32(42)
+
+		assert.Equal(t, sql.NullInt32{Int32: int32(42), Valid: true}, ToNullInt32(&value))
+		assert.Equal(t, sql.NullInt32{}, ToNullInt32(nil))
+	})
+}
+
+func Test_NullInt16(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, int16(42), *FromNullInt16(sql.NullInt16{Int16: int16(42), Valid: true}))
+		assert.Nil(t, FromNullInt16(sql.NullInt16{Int16: int16(42)}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := int16(42)
+
+		assert.Equal(t, sql.NullInt16{Int16: int16(42), Valid: true}, ToNullInt16(&value))
+		assert.Equal(t, sql.NullInt16{}, ToNullInt16(nil))
+	})
+}
+
+func Test_NullByte(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, byte(42), *FromNullByte(sql.NullByte{Byte: byte(42), Valid: true}))
+		assert.Nil(t, FromNullByte(sql.NullByte{Byte: byte(42)}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := byte(42)
+
+		assert.Equal(t, sql.NullByte{Byte: byte(42), Valid: true}, ToNullByte(&value))
+		assert.Equal(t, sql.NullByte{}, ToNullByte(nil))
+	})
+}
+
+func Test_NullFloat64(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, float64(42), *FromNullFloat64(sql.NullFloat64{Float64: float64(42), Valid: true}))
+		assert.Nil(t, FromNullFloat64(sql.NullFloat64{Float64: float64(42)}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := float64(42)
+
+		assert.Equal(t, sql.NullFloat64{Float64: float64(42), Valid: true}, ToNullFloat64(&value))
+		assert.Equal(t, sql.NullFloat64{}, ToNullFloat64(nil))
+	})
+}
+
+func Test_NullBool(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, true, *FromNullBool(sql.NullBool{Bool: true, Valid: true}))
+		assert.Nil(t, FromNullBool(sql.NullBool{Bool: true}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := true
+
+		assert.Equal(t, sql.NullBool{Bool: true, Valid: true}, ToNullBool(&value))
+		assert.Equal(t, sql.NullBool{}, ToNullBool(nil))
+	})
+}
+
+func Test_NullTime(t *testing.T) {
+	t.Run("from", func(t *testing.T) {
+		assert.Equal(t, time.Unix(42, 0), *FromNullTime(sql.NullTime{Time: time.Unix(42, 0), Valid: true}))
+		assert.Nil(t, FromNullTime(sql.NullTime{Time: time.Unix(42, 0)}))
+	})
+
+	t.Run("to", func(t *testing.T) {
+		value := time.Unix(42, 0)
+
+		assert.Equal(t, sql.NullTime{Time: time.Unix(42, 0), Valid: true}, ToNullTime(&value))
+		assert.Equal(t, sql.NullTime{}, ToNullTime(nil))
+	})
+}
@@ -0,0 +1,52 @@
+package ptr
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FromNull(t *testing.T) {
+	assert.Equal(t, To("foo"), FromNull(sql.Null[string]{V: "foo", Valid: true}))
+	assert.Nil(t, FromNull(sql.Null[string]{V: "foo"}))
+}
+
+func Test_ToNull(t *testing.T) {
+	assert.Equal(t, sql.Null[int64]{V: 42, Valid: true}, ToNull(To[int64](42)))
+	assert.Equal(t, sql.Null[int64]{}, ToNull[int64](nil))
+}
+
+func Test_Scanner(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		var name *string
+
+		require.NoError(t, Scanner(&name).Scan("foo"))
+		assert.Equal(t, To("foo"), name)
+
+		require.NoError(t, Scanner(&name).Scan([]byte("bar")))
+		assert.Equal(t, To("bar"), name)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		name := To("foo")
+
+		require.NoError(t, Scanner(&name).Scan(nil))
+		assert.Nil(t, name)
+	})
+
+	t.Run("conversion", func(t *testing.T) {
+		var age *int32
+		require.NoError(t, Scanner(&age).Scan(int64(42)))
+		assert.Equal(t, To[int32](42), age)
+
+		at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		var createdAt *time.Time
+		require.NoError(t, Scanner(&createdAt).Scan(at))
+		assert.Equal(t, &at, createdAt)
+
+		assert.Error(t, Scanner(&age).Scan("foo"))
+	})
+}
@@ -0,0 +1,248 @@
+package ptr
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const tagName = "ptr"
+
+var (
+	errStructDst = errors.New("ptr: dst must be a non-nil pointer to a struct")
+	errStructSrc = errors.New("ptr: src must be a struct or a non-nil pointer to a struct")
+)
+
+// StructToValues copies src into dst, dereferencing pointer fields of src
+// into the matching value fields of dst. Nil pointers become zero values.
+//
+// Fields are matched by name, which the `ptr` tag on either struct can override:
+//
+//	Name string `ptr:"name,omitnil,default=anonymous"`
+//
+// omitnil leaves the dst field untouched when the src field is nil.
+// default= sets the dst field to the parsed default when the src field is nil;
+// as it takes the rest of the tag, it must come last.
+// `ptr:"-"` skips the field. Fields missing from src are left untouched.
+// The secret option only matters to LogValue, and `ptr:"secret"` sets it without renaming.
+//
+// Nested structs, slices and maps are converted recursively, and type
+// mismatches are reported as *ConvertError.
+func StructToValues(dst, src any) error {
+	return structConvert(dst, src)
+}
+
+// StructToPointers copies src into dst, allocating pointers for the value
+// fields of src that match pointer fields of dst.
+//
+// It uses the same field matching and tags as StructToValues, except that
+// omitnil leaves the dst pointer nil when the src field holds its zero value.
+func StructToPointers(dst, src any) error {
+	return structConvert(dst, src)
+}
+
+func structConvert(dst, src any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return errStructDst
+	}
+	s := reflect.ValueOf(src)
+	if s.Kind() == reflect.Pointer && !s.IsNil() {
+		s = s.Elem()
+	}
+	if s.Kind() != reflect.Struct {
+		return errStructSrc
+	}
+	return deepConvertStruct(d.Elem(), s, "")
+}
+
+type fieldTag struct {
+	name       string
+	skip       bool
+	omitNil    bool
+	hasDefault bool
+	def        string
+	secret     bool
+}
+
+func parseFieldTag(f reflect.StructField) fieldTag {
+	tag := fieldTag{name: f.Name}
+	value, ok := f.Tag.Lookup(tagName)
+	if !ok {
+		return tag
+	}
+	if value == "-" {
+		tag.skip = true
+		return tag
+	}
+	name, opts, _ := strings.Cut(value, ",")
+	switch name {
+	case "":
+	case "secret":
+		// a bare secret is the option, as in `ptr:"secret"`
+		tag.secret = true
+	default:
+		tag.name = name
+	}
+	for opts != "" {
+		var opt string
+		if strings.HasPrefix(opts, "default=") {
+			tag.hasDefault = true
+			tag.def = strings.TrimPrefix(opts, "default=")
+			break
+		}
+		opt, opts, _ = strings.Cut(opts, ",")
+		switch opt {
+		case "omitnil":
+			tag.omitNil = true
+		case "secret":
+			tag.secret = true
+		}
+	}
+	return tag
+}
+
+// mergeFieldTags combines the options set on the dst and src fields.
+func mergeFieldTags(dst, src fieldTag) fieldTag {
+	dst.omitNil = dst.omitNil || src.omitNil
+	if !dst.hasDefault && src.hasDefault {
+		dst.hasDefault = true
+		dst.def = src.def
+	}
+	return dst
+}
+
+func structFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := parseFieldTag(f)
+		if tag.skip {
+			continue
+		}
+		fields[tag.name] = f
+	}
+	return fields
+}
+
+func deepConvertStruct(dst, src reflect.Value, path string) error {
+	srcFields := structFields(src.Type())
+	dstType := dst.Type()
+	for i := 0; i < dstType.NumField(); i++ {
+		df := dstType.Field(i)
+		if !df.IsExported() {
+			continue
+		}
+		tag := parseFieldTag(df)
+		if tag.skip {
+			continue
+		}
+		sf, ok := srcFields[tag.name]
+		if !ok {
+			continue
+		}
+		tag = mergeFieldTags(tag, parseFieldTag(sf))
+
+		fieldPath := df.Name
+		if path != "" {
+			fieldPath = path + "." + df.Name
+		}
+		dv := dst.Field(i)
+		sv := src.FieldByIndex(sf.Index)
+		if isNilField(sv, dv) {
+			if tag.hasDefault {
+				if err := setText(dv, tag.def); err != nil {
+					return &ConvertError{Path: fieldPath, Src: reflect.TypeOf(tag.def), Dst: dv.Type(), Reason: err.Error()}
+				}
+				continue
+			}
+			if tag.omitNil {
+				continue
+			}
+		}
+		if err := deepConvert(dv, sv, fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isNilField reports whether src holds no value: a nil pointer or interface,
+// or a zero value about to be converted to a pointer.
+func isNilField(src, dst reflect.Value) bool {
+	switch src.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return src.IsNil()
+	}
+	return dst.Kind() == reflect.Pointer && src.IsZero()
+}
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// setText parses s into v, allocating pointers as needed.
+func setText(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Pointer {
+		p := reflect.New(v.Type().Elem())
+		if err := setText(p.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Complex64, reflect.Complex128:
+		c, err := strconv.ParseComplex(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetComplex(c)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package ptr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sdkAddress struct {
+	Street *string
+	Zip    *string
+}
+
+type sdkUser struct {
+	Name      *string
+	Age       *int64
+	Email     *string `ptr:"Mail"`
+	Role      *string
+	Timeout   *time.Duration
+	Address   *sdkAddress
+	Tags      []*string
+	Labels    map[string]*string
+	CreatedAt *time.Time
+	Internal  *string `ptr:"-"`
+}
+
+type domainAddress struct {
+	Street string
+	Zip    string
+}
+
+type domainUser struct {
+	Name      string
+	Age       int64 `ptr:",omitnil"`
+	Mail      string
+	Role      string        `ptr:",default=viewer"`
+	Timeout   time.Duration `ptr:",default=30s"`
+	Address   domainAddress
+	Tags      []string
+	Labels    map[string]string
+	CreatedAt time.Time
+	Internal  string
+}
+
+func Test_StructToValues(t *testing.T) {
+	createdAt := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("struct", func(t *testing.T) {
+		src := sdkUser{
+			Name:      To("foo"),
+			Age:       To[int64](42),
+			Email:     To("foo@bar.baz"),
+			Role:      To("admin"),
+			Timeout:   To(time.Second),
+			Address:   &sdkAddress{Street: To("Main St")},
+			Tags:      []*string{To("bar"), nil},
+			Labels:    map[string]*string{"baz": To("qux")},
+			CreatedAt: &createdAt,
+			Internal:  To("secret"),
+		}
+		var dst domainUser
+
+		err := StructToValues(&dst, src)
+		require.NoError(t, err)
+		assert.Equal(t, domainUser{
+			Name:      "foo",
+			Age:       42,
+			Mail:      "foo@bar.baz",
+			Role:      "admin",
+			Timeout:   time.Second,
+			Address:   domainAddress{Street: "Main St"},
+			Tags:      []string{"bar", ""},
+			Labels:    map[string]string{"baz": "qux"},
+			CreatedAt: createdAt,
+		}, dst)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		dst := domainUser{Name: "foo", Age: 42}
+
+		err := StructToValues(&dst, &sdkUser{})
+		require.NoError(t, err)
+		assert.Equal(t, domainUser{Age: 42, Role: "viewer", Timeout: 30 * time.Second}, dst)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		type wrongAddress struct {
+			Street *int
+		}
+		src := struct {
+			Address *wrongAddress
+		}{&wrongAddress{To(42)}}
+		var dst domainUser
+
+		err := StructToValues(&dst, src)
+		var convErr *ConvertError
+		require.ErrorAs(t, err, &convErr)
+		assert.Equal(t, "Address.Street", convErr.Path)
+		assert.EqualError(t, err, "ptr: cannot convert Address.Street from int to string: incompatible types")
+	})
+
+	t.Run("invalid default", func(t *testing.T) {
+		src := struct{ Age *int }{}
+		dst := struct {
+			Age int `ptr:",default=foo"`
+		}{}
+
+		err := StructToValues(&dst, src)
+		var convErr *ConvertError
+		require.ErrorAs(t, err, &convErr)
+		assert.Equal(t, "Age", convErr.Path)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		var dst domainUser
+
+		assert.Error(t, StructToValues(dst, sdkUser{}))
+		assert.Error(t, StructToValues(&dst, 42))
+		assert.Error(t, StructToValues(&dst, (*sdkUser)(nil)))
+	})
+}
+
+func Test_StructToPointers(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		src := domainUser{
+			Name:    "foo",
+			Mail:    "foo@bar.baz",
+			Address: domainAddress{Street: "Main St"},
+			Tags:    []string{"bar"},
+			Labels:  map[string]string{"baz": "qux"},
+		}
+		var dst sdkUser
+
+		err := StructToPointers(&dst, &src)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", Value(dst.Name))
+		assert.Nil(t, dst.Age)
+		assert.Equal(t, "foo@bar.baz", Value(dst.Email))
+		assert.Equal(t, "viewer", Value(dst.Role))
+		assert.Equal(t, 30*time.Second, Value(dst.Timeout))
+		require.NotNil(t, dst.Address)
+		assert.Equal(t, "Main St", Value(dst.Address.Street))
+		assert.Equal(t, "", Value(dst.Address.Zip))
+		assert.NotNil(t, dst.Address.Zip)
+		assert.Equal(t, []*string{To("bar")}, dst.Tags)
+		assert.Equal(t, map[string]*string{"baz": To("qux")}, dst.Labels)
+		assert.Nil(t, dst.Internal)
+	})
+}
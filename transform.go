@@ -0,0 +1,45 @@
+package ptr
+
+// Map returns a pointer to f applied to the value p points to, or nil if p is nil.
+func Map[T, U any](p *T, f func(T) U) *U {
+	if p == nil {
+		return nil
+	}
+	return To(f(*p))
+}
+
+// MapErr is like Map for fallible conversions such as strconv.Atoi.
+func MapErr[T, U any](p *T, f func(T) (U, error)) (*U, error) {
+	if p == nil {
+		return nil, nil
+	}
+	v, err := f(*p)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// FlatMap returns f applied to the value p points to, or nil if p is nil.
+func FlatMap[T, U any](p *T, f func(T) *U) *U {
+	if p == nil {
+		return nil
+	}
+	return f(*p)
+}
+
+// Filter returns p if it is non-nil and its value satisfies pred, nil otherwise.
+func Filter[T any](p *T, pred func(T) bool) *T {
+	if p == nil || !pred(*p) {
+		return nil
+	}
+	return p
+}
+
+// Zip returns a pointer to f applied to both values, or nil if either is nil.
+func Zip[A, B, C any](a *A, b *B, f func(A, B) C) *C {
+	if a == nil || b == nil {
+		return nil
+	}
+	return To(f(*a, *b))
+}
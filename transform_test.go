@@ -0,0 +1,94 @@
+package ptr
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Map(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		pointer := Map(To("foo"), strings.ToUpper)
+		assert.Equal(t, "FOO", *pointer)
+	})
+
+	t.Run("int/string", func(t *testing.T) {
+		pointer := Map(To(42), strconv.Itoa)
+		assert.Equal(t, "42", *pointer)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		pointer := Map(nil, strconv.Itoa)
+		assert.Nil(t, pointer)
+	})
+}
+
+func Test_MapErr(t *testing.T) {
+	t.Run("string/int", func(t *testing.T) {
+		pointer, err := MapErr(To("42"), strconv.Atoi)
+		require.NoError(t, err)
+		assert.Equal(t, 42, *pointer)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		pointer, err := MapErr(To("foo"), strconv.Atoi)
+		assert.Error(t, err)
+		assert.Nil(t, pointer)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		pointer, err := MapErr(nil, strconv.Atoi)
+		require.NoError(t, err)
+		assert.Nil(t, pointer)
+	})
+}
+
+func Test_FlatMap(t *testing.T) {
+	nonEmpty := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "foo", *FlatMap(To("foo"), nonEmpty))
+		assert.Nil(t, FlatMap(To(""), nonEmpty))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, FlatMap(nil, nonEmpty))
+	})
+}
+
+func Test_Filter(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+
+	t.Run("int", func(t *testing.T) {
+		pointer := To(42)
+
+		assert.Same(t, pointer, Filter(pointer, positive))
+		assert.Nil(t, Filter(To(-42), positive))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, Filter(nil, positive))
+	})
+}
+
+func Test_Zip(t *testing.T) {
+	repeat := func(s string, n int) string { return strings.Repeat(s, n) }
+
+	t.Run("string/int", func(t *testing.T) {
+		pointer := Zip(To("foo"), To(2), repeat)
+		assert.Equal(t, "foofoo", *pointer)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, Zip(nil, To(2), repeat))
+		assert.Nil(t, Zip(To("foo"), nil, repeat))
+	})
+}